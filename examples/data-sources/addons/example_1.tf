@@ -0,0 +1,10 @@
+data "rhcs_addons" "logging" {
+  search = "id like 'cluster-logging%'"
+}
+
+output "logging_parameters" {
+  value = {
+    for addon in data.rhcs_addons.logging.items :
+    addon.id => [for parameter in addon.parameters : parameter.id]
+  }
+}
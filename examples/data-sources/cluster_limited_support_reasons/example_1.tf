@@ -0,0 +1,10 @@
+data "rhcs_cluster_limited_support_reasons" "reasons" {
+  cluster = rhcs_cluster_rosa_hcp.rosa_hcp_cluster.id
+}
+
+check "full_support" {
+  assert {
+    condition     = length(data.rhcs_cluster_limited_support_reasons.reasons.items) == 0
+    error_message = "The cluster is in limited support: ${join(", ", data.rhcs_cluster_limited_support_reasons.reasons.items[*].summary)}"
+  }
+}
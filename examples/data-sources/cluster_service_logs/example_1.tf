@@ -0,0 +1,12 @@
+data "rhcs_cluster_service_logs" "recent_errors" {
+  cluster    = rhcs_cluster_rosa_hcp.rosa_hcp_cluster.id
+  severities = ["Error", "Critical", "Fatal"]
+  start_time = timeadd(timestamp(), "-24h")
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.items) == 0
+      error_message = "The cluster has recent errors: ${join(", ", self.items[*].summary)}"
+    }
+  }
+}
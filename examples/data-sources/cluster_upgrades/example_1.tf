@@ -0,0 +1,10 @@
+data "rhcs_cluster_upgrades" "upgrades" {
+  cluster = rhcs_cluster_rosa_hcp.rosa_hcp_cluster.id
+}
+
+output "upgrades_needing_acknowledgement" {
+  value = [
+    for upgrade in data.rhcs_cluster_upgrades.upgrades.available_upgrades :
+    upgrade.version if upgrade.acknowledgement_required
+  ]
+}
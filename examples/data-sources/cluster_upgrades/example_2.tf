@@ -0,0 +1,4 @@
+data "rhcs_cluster_upgrades" "workers" {
+  cluster      = rhcs_cluster_rosa_hcp.rosa_hcp_cluster.id
+  machine_pool = rhcs_hcp_machine_pool.workers.id
+}
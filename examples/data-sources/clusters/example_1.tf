@@ -0,0 +1,8 @@
+data "rhcs_clusters" "production" {
+  name_pattern = "prod-*"
+  topology     = "hcp"
+  state        = "ready"
+  tags = {
+    cost-center = "12345"
+  }
+}
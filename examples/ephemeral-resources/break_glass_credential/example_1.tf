@@ -0,0 +1,17 @@
+# Issue a break glass credential for the duration of the run only, it is
+# revoked once Terraform is done and never written to the state
+ephemeral "rhcs_break_glass_credential" "emergency" {
+  cluster             = "cluster-id-123"
+  expiration_duration = "1h"
+}
+
+locals {
+  kubeconfig = yamldecode(ephemeral.rhcs_break_glass_credential.emergency.kubeconfig)
+}
+
+provider "kubernetes" {
+  host                   = local.kubeconfig.clusters[0].cluster.server
+  cluster_ca_certificate = base64decode(local.kubeconfig.clusters[0].cluster["certificate-authority-data"])
+  client_certificate     = base64decode(local.kubeconfig.users[0].user["client-certificate-data"])
+  client_key             = base64decode(local.kubeconfig.users[0].user["client-key-data"])
+}
@@ -0,0 +1,3 @@
+provider "rhcs" {
+  ocm_config_profile = "staging"
+}
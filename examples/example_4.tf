@@ -0,0 +1,3 @@
+provider "rhcs" {
+  environment = "fedramp-production"
+}
@@ -0,0 +1,14 @@
+provider "rhcs" {
+  default_tags {
+    tags = {
+      cost-center = "12345"
+      owner       = "platform-team"
+    }
+  }
+
+  default_properties {
+    properties = {
+      owner = "platform-team"
+    }
+  }
+}
@@ -0,0 +1,3 @@
+locals {
+  supports_feature = provider::rhcs::compare_versions(var.openshift_version, "4.14.0") >= 0
+}
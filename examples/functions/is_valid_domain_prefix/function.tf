@@ -0,0 +1,8 @@
+variable "domain_prefix" {
+  type = string
+
+  validation {
+    condition     = provider::rhcs::is_valid_domain_prefix(var.domain_prefix)
+    error_message = "The domain prefix must be a lowercase DNS label of at most 15 characters."
+  }
+}
@@ -0,0 +1,3 @@
+output "oidc_endpoint_url" {
+  value = provider::rhcs::oidc_endpoint_url(rhcs_rosa_oidc_config_input.oidc_input.issuer_url)
+}
@@ -0,0 +1,3 @@
+output "ingress_operator_role_name" {
+  value = provider::rhcs::operator_role_name(var.operator_role_prefix, "openshift-ingress-operator", "cloud-credentials")
+}
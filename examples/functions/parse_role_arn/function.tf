@@ -0,0 +1,11 @@
+locals {
+  installer_role = provider::rhcs::parse_role_arn(var.installer_role_arn)
+}
+
+output "installer_role_name" {
+  value = local.installer_role.name
+}
+
+output "aws_account_id" {
+  value = local.installer_role.account_id
+}
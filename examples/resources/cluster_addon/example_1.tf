@@ -0,0 +1,8 @@
+resource "rhcs_cluster_addon" "logging" {
+  cluster = rhcs_cluster_rosa_hcp.rosa_hcp_cluster.id
+  addon   = "cluster-logging-operator"
+  parameters = {
+    "use-cloudwatch"       = "true"
+    "cloudwatch-log-group" = "rosa-logs"
+  }
+}
@@ -0,0 +1,30 @@
+# Example external authentication provider
+resource "rhcs_external_auth_provider" "example_external_auth_provider" {
+  cluster = "cluster-id-123"
+  name    = "my-provider"
+  issuer = {
+    url       = "https://login.example.com/realms/rosa"
+    audiences = ["rosa-console"]
+  }
+  claim = {
+    mappings = {
+      username = {
+        claim         = "email"
+        prefix_policy = "NoPrefix"
+      }
+      groups = {
+        claim = "groups"
+      }
+    }
+  }
+  clients = [
+    {
+      id     = "rosa-console"
+      secret = var.console_client_secret
+      component = {
+        name      = "console"
+        namespace = "openshift-console"
+      }
+    }
+  ]
+}
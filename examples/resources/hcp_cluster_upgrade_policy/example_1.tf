@@ -0,0 +1,5 @@
+# Upgrade the control plane to the latest patch version every Sunday at 02:00 UTC
+resource "rhcs_hcp_cluster_upgrade_policy" "example_upgrade_policy" {
+  cluster  = "cluster-id-123"
+  schedule = "0 2 * * 0"
+}
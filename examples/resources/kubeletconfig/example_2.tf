@@ -0,0 +1,21 @@
+# Apply a KubeletConfig to a single machine pool of a Hosted Control Plane cluster
+resource "rhcs_kubeletconfig" "high_pids" {
+  cluster        = "cluster-id-123"
+  name           = "high-pids"
+  pod_pids_limit = 20000
+}
+
+resource "rhcs_hcp_machine_pool" "machine_pool" {
+  cluster  = "cluster-id-123"
+  name     = "my-pool"
+  replicas = 1
+  autoscaling = {
+    enabled = false
+  }
+  subnet_id = "subnet-id-1"
+  aws_node_pool = {
+    instance_type = "m5.xlarge"
+  }
+  auto_repair     = true
+  kubelet_configs = rhcs_kubeletconfig.high_pids.name
+}
@@ -0,0 +1,14 @@
+resource "rhcs_network_verification" "subnets" {
+  subnet_ids   = ["subnet-1", "subnet-2"]
+  cloud_region = "us-east-2"
+  role_arn     = "arn:aws:iam::account-id-123:role/account-prefix-HCP-ROSA-Installer-Role"
+  platform     = "aws-hosted-cp"
+}
+
+resource "rhcs_cluster_rosa_hcp" "rosa_sts_cluster" {
+  name         = "my-cluster"
+  cloud_region = "us-east-2"
+  # Using the subnets of the verification makes the cluster wait till the verification passed:
+  aws_subnet_ids = rhcs_network_verification.subnets.subnet_ids
+  # ...
+}
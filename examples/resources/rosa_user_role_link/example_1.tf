@@ -0,0 +1,5 @@
+# Create the AWS IAM user role separately with the AWS provider, then
+# link its ARN to the current OCM account using this resource.
+resource "rhcs_rosa_user_role_link" "user_role" {
+  role_arn = "arn:aws:iam::123456789012:role/ManagedOpenShift-User-testuser-Role"
+}
@@ -0,0 +1,16 @@
+resource "rhcs_tuning_config" "hcp_tuning_config" {
+  cluster = "cluster-id-123"
+  name    = "my-config"
+  profile = [
+    {
+      name = "tuned-72521-1-profile"
+      data = "[main]\nsummary=Custom OpenShift profile\ninclude=openshift-node\n\n[sysctl]\nvm.dirty_ratio=\"65\"\n"
+    }
+  ]
+  recommend = [
+    {
+      priority = 20
+      profile  = "tuned-72521-1-profile"
+    }
+  ]
+}
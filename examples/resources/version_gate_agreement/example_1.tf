@@ -0,0 +1,18 @@
+data "rhcs_cluster_upgrades" "upgrades" {
+  cluster = rhcs_cluster_rosa_hcp.rosa_hcp_cluster.id
+}
+
+locals {
+  target_upgrade = one([
+    for upgrade in data.rhcs_cluster_upgrades.upgrades.available_upgrades :
+    upgrade if upgrade.version == "4.16.2"
+  ])
+}
+
+resource "rhcs_version_gate_agreement" "gates" {
+  for_each = {
+    for gate in local.target_upgrade.missing_gates : gate.id => gate if !gate.sts_only
+  }
+  cluster      = rhcs_cluster_rosa_hcp.rosa_hcp_cluster.id
+  version_gate = each.key
+}
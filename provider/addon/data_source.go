@@ -0,0 +1,233 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package addon
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+type AddOnsDataSource struct {
+	collection *cmv1.AddOnsClient
+}
+
+var _ datasource.DataSource = &AddOnsDataSource{}
+var _ datasource.DataSourceWithConfigure = &AddOnsDataSource{}
+
+func NewDataSource() datasource.DataSource {
+	return &AddOnsDataSource{}
+}
+
+func (s *AddOnsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_addons"
+}
+
+func (s *AddOnsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List of the add-ons that can be installed on clusters, with the parameters that they support.",
+		Attributes: map[string]schema.Attribute{
+			"search": schema.StringAttribute{
+				Description: "OCM search expression, for example `id like 'cluster-logging%'`.",
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "Add-ons that match the search.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: s.itemAttributes(),
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (s *AddOnsDataSource) itemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Unique identifier of the add-on, used in the `addon` attribute of `rhcs_cluster_addon`.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the add-on.",
+			Computed:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "Description of the add-on.",
+			Computed:    true,
+		},
+		"version": schema.StringAttribute{
+			Description: "Current version of the add-on.",
+			Computed:    true,
+		},
+		"docs_link": schema.StringAttribute{
+			Description: "Link to the documentation of the add-on.",
+			Computed:    true,
+		},
+		"parameters": schema.ListNestedAttribute{
+			Description: "Parameters supported by the add-on.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "Identifier of the parameter, used as key in the `parameters` attribute " +
+							"of `rhcs_cluster_addon`.",
+						Computed: true,
+					},
+					"name": schema.StringAttribute{
+						Description: "Name of the parameter.",
+						Computed:    true,
+					},
+					"description": schema.StringAttribute{
+						Description: "Description of the parameter.",
+						Computed:    true,
+					},
+					"value_type": schema.StringAttribute{
+						Description: "Type of the value of the parameter, for example 'string', 'number' or 'boolean'.",
+						Computed:    true,
+					},
+					"required": schema.BoolAttribute{
+						Description: "Indicates if the parameter must be given when installing the add-on.",
+						Computed:    true,
+					},
+					"editable": schema.BoolAttribute{
+						Description: "Indicates if the parameter can be changed once the add-on is installed.",
+						Computed:    true,
+					},
+					"default_value": schema.StringAttribute{
+						Description: "Value used when the parameter isn't given.",
+						Computed:    true,
+					},
+					"validation": schema.StringAttribute{
+						Description: "Regular expression that the value must match.",
+						Computed:    true,
+					},
+					"options": schema.ListNestedAttribute{
+						Description: "Values allowed for the parameter. Any value is allowed when empty.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Description: "Name of the option.",
+									Computed:    true,
+								},
+								"value": schema.StringAttribute{
+									Description: "Value of the option.",
+									Computed:    true,
+								},
+							},
+						},
+						Computed: true,
+					},
+				},
+			},
+			Computed: true,
+		},
+	}
+}
+
+func (s *AddOnsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured:
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Cast the provider data to the specific implementation:
+	connection := req.ProviderData.(*common.ProviderData).Connection
+
+	// Get the collection of add-ons:
+	s.collection = connection.ClustersMgmt().V1().Addons()
+}
+
+func (s *AddOnsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the state:
+	state := &AddOnsState{}
+	diags := req.Config.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch the list of add-ons, skipping the ones that can't be installed:
+	var listItems []*cmv1.AddOn
+	listSize := 100
+	listPage := 1
+	listRequest := s.collection.List().Size(listSize)
+	if common.HasValue(state.Search) {
+		listRequest.Search(state.Search.ValueString())
+	}
+	for {
+		listResponse, err := listRequest.SendContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Can't list add-ons",
+				err.Error(),
+			)
+			return
+		}
+		if listItems == nil {
+			listItems = make([]*cmv1.AddOn, 0, listResponse.Total())
+		}
+		listResponse.Items().Each(func(listItem *cmv1.AddOn) bool {
+			if listItem.Enabled() && !listItem.Hidden() {
+				listItems = append(listItems, listItem)
+			}
+			return true
+		})
+		if listResponse.Size() < listSize {
+			break
+		}
+		listPage++
+		listRequest.Page(listPage)
+	}
+
+	// Populate the state:
+	state.Items = make([]*AddOnItemState, len(listItems))
+	for i, listItem := range listItems {
+		state.Items[i] = &AddOnItemState{
+			ID:          types.StringValue(listItem.ID()),
+			Name:        types.StringValue(listItem.Name()),
+			Description: types.StringValue(listItem.Description()),
+			Version:     types.StringValue(listItem.Version().ID()),
+			DocsLink:    types.StringValue(listItem.DocsLink()),
+			Parameters:  parametersState(listItem),
+		}
+	}
+
+	// Save the state:
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func parametersState(addOn *cmv1.AddOn) []*AddOnParameterState {
+	result := []*AddOnParameterState{}
+	addOn.Parameters().Each(func(parameter *cmv1.AddOnParameter) bool {
+		if !parameter.Enabled() {
+			return true
+		}
+		options := make([]*AddOnOptionState, len(parameter.Options()))
+		for i, option := range parameter.Options() {
+			options[i] = &AddOnOptionState{
+				Name:  types.StringValue(option.Name()),
+				Value: types.StringValue(option.Value()),
+			}
+		}
+		result = append(result, &AddOnParameterState{
+			ID:           types.StringValue(parameter.ID()),
+			Name:         types.StringValue(parameter.Name()),
+			Description:  types.StringValue(parameter.Description()),
+			ValueType:    types.StringValue(parameter.ValueType()),
+			Required:     types.BoolValue(parameter.Required()),
+			Editable:     types.BoolValue(parameter.Editable()),
+			DefaultValue: types.StringValue(parameter.DefaultValue()),
+			Validation:   types.StringValue(parameter.Validation()),
+			Options:      options,
+		})
+		return true
+	})
+	return result
+}
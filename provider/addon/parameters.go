@@ -0,0 +1,129 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package addon
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// enabledParameters returns the parameters of the add-on that can be set, indexed by identifier.
+func enabledParameters(addOn *cmv1.AddOn) map[string]*cmv1.AddOnParameter {
+	result := map[string]*cmv1.AddOnParameter{}
+	addOn.Parameters().Each(func(parameter *cmv1.AddOnParameter) bool {
+		if parameter.Enabled() {
+			result[parameter.ID()] = parameter
+		}
+		return true
+	})
+	return result
+}
+
+// validateParameters checks the given parameter values against the schema of the add-on and
+// returns a description of each problem found. The current values are the ones of the existing
+// installation, and are nil when the add-on isn't installed yet.
+func validateParameters(addOn *cmv1.AddOn, values, current map[string]string) []string {
+	schema := enabledParameters(addOn)
+	problems := []string{}
+
+	ids := make([]string, 0, len(values))
+	for id := range values {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		value := values[id]
+		parameter, ok := schema[id]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("parameter '%s' isn't supported by add-on '%s', "+
+				"the supported parameters are: %s", id, addOn.ID(), supportedParameters(schema)))
+			continue
+		}
+		if current != nil && !parameter.Editable() {
+			if currentValue, ok := current[id]; ok && currentValue != value {
+				problems = append(problems, fmt.Sprintf("parameter '%s' can't be changed after the "+
+					"add-on is installed", id))
+				continue
+			}
+		}
+		if problem := validateValue(parameter, value); problem != "" {
+			problems = append(problems, fmt.Sprintf("invalid value '%s' for parameter '%s': %s", value, id, problem))
+		}
+	}
+
+	// Required parameters only need to be given on installation, and only when the add-on doesn't
+	// have a default for them:
+	if current == nil {
+		required := []string{}
+		for id, parameter := range schema {
+			if _, ok := values[id]; !ok && parameter.Required() && parameter.DefaultValue() == "" {
+				required = append(required, id)
+			}
+		}
+		sort.Strings(required)
+		for _, id := range required {
+			problems = append(problems, fmt.Sprintf("parameter '%s' is required by add-on '%s'", id, addOn.ID()))
+		}
+	}
+
+	return problems
+}
+
+// validateValue checks one value against the type, options and validation expression of the
+// parameter, and returns the problem found, or an empty string.
+func validateValue(parameter *cmv1.AddOnParameter, value string) string {
+	if options := parameter.Options(); len(options) > 0 {
+		valid := make([]string, len(options))
+		for i, option := range options {
+			if option.Value() == value {
+				return ""
+			}
+			valid[i] = fmt.Sprintf("'%s'", option.Value())
+		}
+		return fmt.Sprintf("must be one of %s", strings.Join(valid, ", "))
+	}
+	switch parameter.ValueType() {
+	case "boolean":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return "must be 'true' or 'false'"
+		}
+	case "number":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return "must be a number"
+		}
+	case "cidr":
+		if _, _, err := net.ParseCIDR(value); err != nil {
+			return "must be a CIDR block, for example '10.0.0.0/16'"
+		}
+	}
+	if validation := parameter.Validation(); validation != "" {
+		expression, err := regexp.Compile(validation)
+		// Expressions that the Go syntax doesn't support are left for the server to check:
+		if err == nil && !expression.MatchString(value) {
+			if parameter.ValidationErrMsg() != "" {
+				return parameter.ValidationErrMsg()
+			}
+			return fmt.Sprintf("must match the regular expression '%s'", validation)
+		}
+	}
+	return ""
+}
+
+func supportedParameters(schema map[string]*cmv1.AddOnParameter) string {
+	if len(schema) == 0 {
+		return "none"
+	}
+	ids := make([]string, 0, len(schema))
+	for id := range schema {
+		ids = append(ids, fmt.Sprintf("'%s'", id))
+	}
+	sort.Strings(ids)
+	return strings.Join(ids, ", ")
+}
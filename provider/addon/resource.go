@@ -0,0 +1,517 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package addon
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+type ClusterAddOnResource struct {
+	collection      *cmv1.ClustersClient
+	addOns          *cmv1.AddOnsClient
+	clusterWait     common.ClusterWait
+	pollingInterval time.Duration
+}
+
+var _ resource.Resource = &ClusterAddOnResource{}
+var _ resource.ResourceWithConfigure = &ClusterAddOnResource{}
+var _ resource.ResourceWithImportState = &ClusterAddOnResource{}
+var _ resource.ResourceWithModifyPlan = &ClusterAddOnResource{}
+
+func New() resource.Resource {
+	return &ClusterAddOnResource{}
+}
+
+func (r *ClusterAddOnResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_addon"
+}
+
+func (r *ClusterAddOnResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Installs an add-on on a cluster and waits till it is ready. The parameters are checked " +
+			"against the parameters supported by the add-on when planning, those are listed by the " +
+			"`rhcs_addons` data source.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Identifier of the cluster. " + common.ValueCannotBeChangedStringDescription,
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`), "cluster ID may not be empty/blank string"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the add-on installation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"addon": schema.StringAttribute{
+				Description: "Identifier of the add-on, for example 'cluster-logging-operator'. " +
+					common.ValueCannotBeChangedStringDescription,
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`), "add-on ID may not be empty/blank string"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Description: "Version of the add-on. Defaults to the current version of the add-on. " +
+					"Changing it upgrades the add-on.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"parameters": schema.MapAttribute{
+				Description: "Values of the parameters of the add-on, indexed by parameter identifier. " +
+					"Parameters that aren't editable can't be changed once the add-on is installed.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the add-on installation, for example 'ready'.",
+				Computed:    true,
+			},
+			"state_description": schema.StringAttribute{
+				Description: "Description of the state of the add-on installation.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *ClusterAddOnResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*common.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	connection := providerData.Connection
+
+	r.collection = connection.ClustersMgmt().V1().Clusters()
+	r.addOns = connection.ClustersMgmt().V1().Addons()
+	r.clusterWait = common.NewClusterWait(r.collection, connection,
+		common.WithPollingInterval(providerData.ClusterWaitPollingInterval))
+	r.pollingInterval = providerData.ClusterWaitPollingInterval
+}
+
+func (r *ClusterAddOnResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying, or before the provider is configured:
+	if req.Plan.Raw.IsNull() || r.addOns == nil {
+		return
+	}
+	plan := &ClusterAddOnState{}
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !common.HasValue(plan.AddOn) || plan.Parameters.IsUnknown() {
+		return
+	}
+	values, err := common.OptionalMap(ctx, plan.Parameters)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("parameters"), "Invalid add-on parameters", err.Error())
+		return
+	}
+	for _, value := range plan.Parameters.Elements() {
+		// The values aren't known till other resources are applied:
+		if value.IsUnknown() {
+			return
+		}
+	}
+
+	// The current values are only relevant when the same add-on is already installed:
+	var current map[string]string
+	if !req.State.Raw.IsNull() {
+		state := &ClusterAddOnState{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if state.AddOn.ValueString() == plan.AddOn.ValueString() &&
+			state.Cluster.ValueString() == plan.Cluster.ValueString() {
+			current, err = common.OptionalMap(ctx, state.Parameters)
+			if err != nil {
+				resp.Diagnostics.AddError("Invalid add-on parameters", err.Error())
+				return
+			}
+			if current == nil {
+				current = map[string]string{}
+			}
+			// Values that were already accepted don't need to be checked again:
+			if maps.Equal(current, values) {
+				return
+			}
+		}
+	}
+
+	addOnId := plan.AddOn.ValueString()
+	getResp, err := r.addOns.Addon(addOnId).Get().SendContext(ctx)
+	if err != nil {
+		if getResp != nil && getResp.Status() == http.StatusNotFound {
+			resp.Diagnostics.AddAttributeError(path.Root("addon"), "Add-on not found",
+				fmt.Sprintf("Add-on '%s' doesn't exist", addOnId))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Can't get add-on",
+			fmt.Sprintf("Can't get add-on '%s' to validate its parameters: %v", addOnId, err),
+		)
+		return
+	}
+
+	for _, problem := range validateParameters(getResp.Body(), values, current) {
+		resp.Diagnostics.AddAttributeError(path.Root("parameters"), "Invalid add-on parameters", problem)
+	}
+}
+
+func (r *ClusterAddOnResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &ClusterAddOnState{}
+	diags := req.Plan.Get(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clusterId := plan.Cluster.ValueString()
+	addOnId := plan.AddOn.ValueString()
+
+	waitTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultAddOnTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, err := r.clusterWait.WaitForClusterToBeReady(ctx, clusterId, common.DurationToMinutes(waitTimeout))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Cannot poll cluster state",
+			fmt.Sprintf(
+				"Cannot poll state of cluster with identifier '%s': %v",
+				clusterId, err,
+			),
+		)
+		return
+	}
+
+	builder := cmv1.NewAddOnInstallation().
+		ID(addOnId).
+		Addon(cmv1.NewAddOn().ID(addOnId))
+	if common.HasValue(plan.Version) {
+		builder.AddonVersion(cmv1.NewAddOnVersion().ID(plan.Version.ValueString()))
+	}
+	parameters, err := buildParameters(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid add-on parameters", err.Error())
+		return
+	}
+	if parameters != nil {
+		builder.Parameters(parameters)
+	}
+	object, err := builder.Build()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't build add-on installation",
+			fmt.Sprintf("Can't build installation of add-on '%s' for cluster '%s': %v", addOnId, clusterId, err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Installing add-on", map[string]any{
+		"cluster": clusterId,
+		"addon":   addOnId,
+	})
+	addResp, err := r.collection.Cluster(clusterId).Addons().Add().Body(object).SendContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't install add-on",
+			fmt.Sprintf("Can't install add-on '%s' on cluster '%s': %v", addOnId, clusterId, err),
+		)
+		return
+	}
+	installation := addResp.Body()
+
+	// Save the state before waiting, so that an installation that doesn't complete is tracked, and
+	// replaced by the next apply:
+	populateState(ctx, installation, plan, false)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	installation, err = r.waitForInstallation(ctx, clusterId, installation.ID(), waitTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Add-on installation didn't complete", err.Error())
+		return
+	}
+	populateState(ctx, installation, plan, false)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ClusterAddOnResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &ClusterAddOnState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clusterId := state.Cluster.ValueString()
+	installationId := state.ID.ValueString()
+
+	getResp, err := r.collection.Cluster(clusterId).Addons().Addoninstallation(installationId).Get().
+		SendContext(ctx)
+	if err != nil {
+		if getResp != nil && getResp.Status() == http.StatusNotFound {
+			tflog.Warn(ctx, "Add-on installation not found, removing from state", map[string]any{
+				"cluster": clusterId,
+				"addon":   installationId,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Can't get add-on installation",
+			fmt.Sprintf("Can't get installation of add-on '%s' for cluster '%s': %v", installationId, clusterId, err),
+		)
+		return
+	}
+
+	// Imported resources only have the cluster and the identifier, in that case all the parameters
+	// of the installation are added to the state:
+	imported := state.AddOn.IsNull()
+	populateState(ctx, getResp.Body(), state, imported)
+	if imported {
+		state.Timeouts = common.TimeoutsNull(timeouts.Opts{Create: true, Update: true, Delete: true})
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ClusterAddOnResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	state := &ClusterAddOnState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan := &ClusterAddOnState{}
+	diags = req.Plan.Get(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clusterId := state.Cluster.ValueString()
+	installationId := state.ID.ValueString()
+
+	waitTimeout, diags := plan.Timeouts.Update(ctx, common.DefaultAddOnTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	builder := cmv1.NewAddOnInstallation().ID(installationId)
+	if common.HasValue(plan.Version) && plan.Version.ValueString() != state.Version.ValueString() {
+		builder.AddonVersion(cmv1.NewAddOnVersion().ID(plan.Version.ValueString()))
+	}
+	parameters, err := buildParameters(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid add-on parameters", err.Error())
+		return
+	}
+	if parameters != nil {
+		builder.Parameters(parameters)
+	}
+	object, err := builder.Build()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't build add-on installation",
+			fmt.Sprintf("Can't build installation of add-on '%s' for cluster '%s': %v", installationId, clusterId, err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Updating add-on", map[string]any{
+		"cluster": clusterId,
+		"addon":   installationId,
+	})
+	client := r.collection.Cluster(clusterId).Addons().Addoninstallation(installationId)
+	_, err = client.Update().Body(object).SendContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't update add-on",
+			fmt.Sprintf("Can't update add-on '%s' on cluster '%s': %v", installationId, clusterId, err),
+		)
+		return
+	}
+
+	installation, err := r.waitForInstallation(ctx, clusterId, installationId, waitTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Add-on update didn't complete", err.Error())
+		return
+	}
+	populateState(ctx, installation, plan, false)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ClusterAddOnResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &ClusterAddOnState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clusterId := state.Cluster.ValueString()
+	installationId := state.ID.ValueString()
+
+	waitTimeout, diags := state.Timeouts.Delete(ctx, common.DefaultAddOnTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Uninstalling add-on", map[string]any{
+		"cluster": clusterId,
+		"addon":   installationId,
+	})
+	client := r.collection.Cluster(clusterId).Addons().Addoninstallation(installationId)
+	deleteResp, err := client.Delete().SendContext(ctx)
+	if err != nil {
+		if deleteResp != nil && deleteResp.Status() == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Can't uninstall add-on",
+			fmt.Sprintf("Can't uninstall add-on '%s' from cluster '%s': %v", installationId, clusterId, err),
+		)
+		return
+	}
+
+	_, err = common.WaitForAddOnInstallation(ctx, client, addOnTarget(clusterId, installationId), waitTimeout,
+		r.pollingInterval, true)
+	if err != nil {
+		resp.Diagnostics.AddError("Add-on removal didn't complete", err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ClusterAddOnResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import identifier",
+			"Expected import identifier with format: cluster_id,addon_id",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+func (r *ClusterAddOnResource) waitForInstallation(ctx context.Context, clusterId, installationId string,
+	timeout time.Duration) (*cmv1.AddOnInstallation, error) {
+	client := r.collection.Cluster(clusterId).Addons().Addoninstallation(installationId)
+	return common.WaitForAddOnInstallation(ctx, client, addOnTarget(clusterId, installationId), timeout,
+		r.pollingInterval, false)
+}
+
+func addOnTarget(clusterId, installationId string) string {
+	return fmt.Sprintf("add-on '%s' of cluster '%s'", installationId, clusterId)
+}
+
+func buildParameters(ctx context.Context, plan *ClusterAddOnState) (*cmv1.AddOnInstallationParameterListBuilder, error) {
+	values, err := common.OptionalMap(ctx, plan.Parameters)
+	if err != nil || values == nil {
+		return nil, err
+	}
+	items := make([]*cmv1.AddOnInstallationParameterBuilder, 0, len(values))
+	for _, id := range slices.Sorted(maps.Keys(values)) {
+		items = append(items, cmv1.NewAddOnInstallationParameter().ID(id).Value(values[id]))
+	}
+	return cmv1.NewAddOnInstallationParameterList().Items(items...), nil
+}
+
+// populateState copies the installation to the state. Only the parameters that are already in the
+// state are updated, unless all is true, as the installation also contains the parameters that
+// the user didn't set.
+func populateState(ctx context.Context, installation *cmv1.AddOnInstallation, state *ClusterAddOnState, all bool) {
+	state.ID = types.StringValue(installation.ID())
+	if addOnId := installation.Addon().ID(); addOnId != "" {
+		state.AddOn = types.StringValue(addOnId)
+	} else if state.AddOn.IsNull() {
+		state.AddOn = types.StringValue(installation.ID())
+	}
+	if version := installation.AddonVersion().ID(); version != "" {
+		state.Version = types.StringValue(version)
+	} else if state.Version.IsUnknown() {
+		state.Version = types.StringNull()
+	}
+	state.State = types.StringValue(string(installation.State()))
+	state.StateDescription = types.StringValue(installation.StateDescription())
+
+	current, err := common.OptionalMap(ctx, state.Parameters)
+	if err != nil {
+		return
+	}
+	values := map[string]string{}
+	installation.Parameters().Each(func(parameter *cmv1.AddOnInstallationParameter) bool {
+		if _, ok := current[parameter.ID()]; ok || all {
+			values[parameter.ID()] = parameter.Value()
+		}
+		return true
+	})
+	if current == nil && len(values) == 0 {
+		return
+	}
+	if current != nil {
+		// Parameters that the server doesn't return keep the configured value:
+		for id, value := range current {
+			if _, ok := values[id]; !ok {
+				values[id] = value
+			}
+		}
+	}
+	state.Parameters, _ = common.ConvertStringMapToMapType(values)
+}
@@ -0,0 +1,51 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package addon
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ClusterAddOnState struct {
+	Cluster          types.String   `tfsdk:"cluster"`
+	ID               types.String   `tfsdk:"id"`
+	AddOn            types.String   `tfsdk:"addon"`
+	Version          types.String   `tfsdk:"version"`
+	Parameters       types.Map      `tfsdk:"parameters"`
+	State            types.String   `tfsdk:"state"`
+	StateDescription types.String   `tfsdk:"state_description"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+type AddOnsState struct {
+	Search types.String      `tfsdk:"search"`
+	Items  []*AddOnItemState `tfsdk:"items"`
+}
+
+type AddOnItemState struct {
+	ID          types.String           `tfsdk:"id"`
+	Name        types.String           `tfsdk:"name"`
+	Description types.String           `tfsdk:"description"`
+	Version     types.String           `tfsdk:"version"`
+	DocsLink    types.String           `tfsdk:"docs_link"`
+	Parameters  []*AddOnParameterState `tfsdk:"parameters"`
+}
+
+type AddOnParameterState struct {
+	ID           types.String        `tfsdk:"id"`
+	Name         types.String        `tfsdk:"name"`
+	Description  types.String        `tfsdk:"description"`
+	ValueType    types.String        `tfsdk:"value_type"`
+	Required     types.Bool          `tfsdk:"required"`
+	Editable     types.Bool          `tfsdk:"editable"`
+	DefaultValue types.String        `tfsdk:"default_value"`
+	Validation   types.String        `tfsdk:"validation"`
+	Options      []*AddOnOptionState `tfsdk:"options"`
+}
+
+type AddOnOptionState struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
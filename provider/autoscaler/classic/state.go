@@ -17,6 +17,7 @@ limitations under the License.
 package classic
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/autoscaler"
@@ -34,6 +35,8 @@ type ClusterAutoscalerState struct {
 	BalancingIgnoredLabels      types.List                 `tfsdk:"balancing_ignored_labels"`
 	ResourceLimits              *AutoscalerResourceLimits  `tfsdk:"resource_limits"`
 	ScaleDown                   *AutoscalerScaleDownConfig `tfsdk:"scale_down"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type AutoscalerResourceLimits struct {
@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -30,7 +31,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/autoscaler"
@@ -94,6 +94,9 @@ func (r *ClusterAutoscalerResource) Schema(ctx context.Context, req resource.Sch
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true}),
+		},
 	}
 	return
 }
@@ -102,16 +105,18 @@ func (r *ClusterAutoscalerResource) Configure(ctx context.Context, req resource.
 		return
 	}
 
-	connection, ok := req.ProviderData.(*sdk.Connection)
+	providerData, ok := req.ProviderData.(*common.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.Connaction, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
+	connection := providerData.Connection
 
 	r.collection = connection.ClustersMgmt().V1().Clusters()
-	r.clusterWait = common.NewClusterWait(r.collection, connection)
+	r.clusterWait = common.NewClusterWait(r.collection, connection,
+		common.WithPollingInterval(providerData.ClusterWaitPollingInterval))
 }
 
 func (r *ClusterAutoscalerResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -123,7 +128,12 @@ func (r *ClusterAutoscalerResource) Create(ctx context.Context, request resource
 	}
 
 	// Wait till the cluster is ready:
-	_, err := r.clusterWait.WaitForClusterToBeReady(ctx, plan.Cluster.ValueString(), 60)
+	waitTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultClusterReadyTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	_, err := r.clusterWait.WaitForClusterToBeReady(ctx, plan.Cluster.ValueString(), common.DurationToMinutes(waitTimeout))
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Cannot poll cluster state",
@@ -163,6 +173,7 @@ func (r *ClusterAutoscalerResource) Create(ctx context.Context, request resource
 	object := update.Body()
 	state := &ClusterAutoscalerState{}
 	populateAutoscalerState(object, plan.Cluster.ValueString(), state)
+	state.Timeouts = plan.Timeouts
 
 	diags = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diags...)
@@ -272,6 +283,7 @@ func (r *ClusterAutoscalerResource) Update(ctx context.Context, request resource
 	object := update.Body()
 	state = &ClusterAutoscalerState{}
 	populateAutoscalerState(object, plan.Cluster.ValueString(), state)
+	state.Timeouts = plan.Timeouts
 
 	diags = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diags...)
@@ -17,6 +17,7 @@ limitations under the License.
 package hcp
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -26,6 +27,8 @@ type ClusterAutoscalerState struct {
 	PodPriorityThreshold types.Int64               `tfsdk:"pod_priority_threshold"`
 	MaxNodeProvisionTime types.String              `tfsdk:"max_node_provision_time"`
 	ResourceLimits       *AutoscalerResourceLimits `tfsdk:"resource_limits"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type AutoscalerResourceLimits struct {
@@ -0,0 +1,215 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package breakglasscredential
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+// Key of the private data holding the credential to revoke when closing
+const revocationPrivateKey = "revocation"
+
+// Expiration of the credentials issued without an explicit 'expiration_duration'
+const defaultEphemeralExpirationDuration = "1h"
+
+type revocationData struct {
+	Cluster string `json:"cluster"`
+	Id      string `json:"id"`
+}
+
+type BreakGlassCredentialEphemeralResource struct {
+	collection    *cmv1.ClustersClient
+	clusterClient common.ClusterClient
+}
+
+func NewEphemeral() ephemeral.EphemeralResource {
+	return &BreakGlassCredentialEphemeralResource{}
+}
+
+var _ ephemeral.EphemeralResource = &BreakGlassCredentialEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &BreakGlassCredentialEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &BreakGlassCredentialEphemeralResource{}
+
+func (b *BreakGlassCredentialEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest,
+	resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_break_glass_credential"
+}
+
+func (b *BreakGlassCredentialEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest,
+	resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Issue a short-lived cluster break glass credential that is not persisted in the Terraform state. " +
+			"It is revoked when Terraform is done using it, and expires after `expiration_duration` in any case.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Identifier of the cluster.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`), "cluster ID may not be empty/blank string"),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "User name of the break glass credential.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-zA-Z0-9-.]*$`), "The username '%s' must respect the regexp '^[a-zA-Z0-9-.]*$'"),
+					// Maximum length for generated common name is 64 characters
+					// 35 characters for "system:customer-break-glass:" + username
+					stringvalidator.LengthAtMost(35),
+				},
+			},
+			"expiration_duration": schema.StringAttribute{
+				Description: "Expire the break glass credential after a relative duration like 2h, 8h. " +
+					"It bounds the lifetime of the credential in case it isn't revoked. Default value is '" +
+					defaultEphemeralExpirationDuration + "'.",
+				Optional: true,
+				Validators: []validator.String{
+					expirationDurationValidator,
+				},
+			},
+			"revoke_on_close": schema.BoolAttribute{
+				Description: "Revoke the break glass credentials of the cluster when Terraform is done using this one. " +
+					"OCM doesn't support revoking a single credential, so this revokes all the break glass credentials " +
+					"issued for the cluster, including the ones managed by 'rhcs_break_glass_credential' resources " +
+					"and by other users, on every plan and apply. Set it to 'false' when other break glass credentials " +
+					"of the cluster must stay valid, and rely on `expiration_duration` instead. Default value is 'true'.",
+				Optional: true,
+			},
+			"id": schema.StringAttribute{
+				Description: "Identifier of the break glass credential.",
+				Computed:    true,
+			},
+			"expiration_timestamp": schema.StringAttribute{
+				Description: "Expiration timestamp of the break glass credential.",
+				Computed:    true,
+			},
+			"revocation_timestamp": schema.StringAttribute{
+				Description: "Revocation timestamp of the break glass credential.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the break glass credential.",
+				Computed:    true,
+			},
+			"kubeconfig": schema.StringAttribute{
+				Description: "Kubeconfig of the break glass credential.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (b *BreakGlassCredentialEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest,
+	resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*common.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	connection := providerData.Connection
+
+	b.collection = connection.ClustersMgmt().V1().Clusters()
+	b.clusterClient = common.NewClusterClient(b.collection)
+}
+
+func (b *BreakGlassCredentialEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse) {
+	config := &BreakGlassCredentialEphemeral{}
+	diags := req.Config.Get(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterId := config.Cluster.ValueString()
+	resp.Diagnostics.Append(validateClusterSupportsBreakGlassCredentials(ctx, b.clusterClient, clusterId)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !common.HasValue(config.ExpirationDuration) || config.ExpirationDuration.ValueString() == "" {
+		config.ExpirationDuration = types.StringValue(defaultEphemeralExpirationDuration)
+	}
+	err := createBreakGlassCredential(ctx, b.collection, &config.BreakGlassCredential)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed creating cluster break glass credential",
+			fmt.Sprintf(
+				"Failed creating break glass credential for cluster '%s': %v",
+				clusterId, err,
+			),
+		)
+		return
+	}
+
+	if common.BoolWithTrueDefault(config.RevokeOnClose) {
+		data, err := json.Marshal(revocationData{Cluster: clusterId, Id: config.Id.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed storing break glass credential revocation data", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, revocationPrivateKey, data)...)
+	}
+
+	diags = resp.Result.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (b *BreakGlassCredentialEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest,
+	resp *ephemeral.CloseResponse) {
+	data, diags := req.Private.GetKey(ctx, revocationPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || data == nil {
+		return
+	}
+
+	revocation := revocationData{}
+	if err := json.Unmarshal(data, &revocation); err != nil {
+		resp.Diagnostics.AddError("Failed reading break glass credential revocation data", err.Error())
+		return
+	}
+
+	if err := revokeBreakGlassCredentials(ctx, b.collection, revocation.Cluster); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed revoking cluster break glass credential",
+			fmt.Sprintf(
+				"Failed revoking break glass credential '%s' for cluster '%s', "+
+					"it will remain valid until it expires: %v",
+				revocation.Id, revocation.Cluster, err,
+			),
+		)
+	}
+}
@@ -23,12 +23,12 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
@@ -130,13 +130,14 @@ func (b *BreakGlassCredentialResource) Configure(ctx context.Context, req resour
 		return
 	}
 
-	connection, ok := req.ProviderData.(*sdk.Connection)
+	providerData, ok := req.ProviderData.(*common.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.Connaction, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
+	connection := providerData.Connection
 
 	b.collection = connection.ClustersMgmt().V1().Clusters()
 	b.clusterClient = common.NewClusterClient(b.collection)
@@ -151,31 +152,12 @@ func (b *BreakGlassCredentialResource) Create(ctx context.Context, req resource.
 	}
 
 	clusterId := plan.Cluster.ValueString()
-	cluster, err := b.clusterClient.FetchCluster(ctx, clusterId)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Can't check retrieve cluster",
-			err.Error(),
-		)
-		return
-	}
-	if !cluster.Hypershift().Enabled() {
-		resp.Diagnostics.AddError(
-			"Unsupported Cluster Type",
-			"Break glass credentials are only supported on Hosted Control Plane clusters",
-		)
-		return
-	}
-	if !cluster.ExternalAuthConfig().Enabled() {
-		resp.Diagnostics.AddError(
-			"External Authentication Configuration is not enabled",
-			fmt.Sprintf("External Authentication Configuration is not enabled for cluster '%s'",
-				clusterId),
-		)
+	resp.Diagnostics.Append(validateClusterSupportsBreakGlassCredentials(ctx, b.clusterClient, clusterId)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err = b.createBreakGlassCredential(ctx, plan)
+	err := createBreakGlassCredential(ctx, b.collection, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed creating cluster break glass credential",
@@ -268,11 +250,41 @@ func (b *BreakGlassCredentialResource) getBreakGlassCredential(
 		return err
 	}
 
-	b.populateState(breakGlassCredential.Body(), state)
+	populateState(breakGlassCredential.Body(), state)
 	return nil
 }
 
-func (b *BreakGlassCredentialResource) createBreakGlassCredential(ctx context.Context,
+// Checks that break glass credentials can be issued for the cluster, they are
+// only available on Hosted Control Plane clusters with external authentication
+func validateClusterSupportsBreakGlassCredentials(ctx context.Context, clusterClient common.ClusterClient,
+	clusterId string) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	cluster, err := clusterClient.FetchCluster(ctx, clusterId)
+	if err != nil {
+		diags.AddError(
+			"Can't check retrieve cluster",
+			err.Error(),
+		)
+		return diags
+	}
+	if !cluster.Hypershift().Enabled() {
+		diags.AddError(
+			"Unsupported Cluster Type",
+			"Break glass credentials are only supported on Hosted Control Plane clusters",
+		)
+		return diags
+	}
+	if !cluster.ExternalAuthConfig().Enabled() {
+		diags.AddError(
+			"External Authentication Configuration is not enabled",
+			fmt.Sprintf("External Authentication Configuration is not enabled for cluster '%s'",
+				clusterId),
+		)
+	}
+	return diags
+}
+
+func createBreakGlassCredential(ctx context.Context, collection *cmv1.ClustersClient,
 	state *BreakGlassCredential) error {
 
 	builder := cmv1.NewBreakGlassCredential()
@@ -291,7 +303,7 @@ func (b *BreakGlassCredentialResource) createBreakGlassCredential(ctx context.Co
 	if err != nil {
 		return err
 	}
-	resp, err := b.collection.Cluster(state.Cluster.ValueString()).BreakGlassCredentials().Add().Body(breakGlassCredential).Send()
+	resp, err := collection.Cluster(state.Cluster.ValueString()).BreakGlassCredentials().Add().Body(breakGlassCredential).Send()
 	if err != nil {
 		return err
 	}
@@ -299,7 +311,7 @@ func (b *BreakGlassCredentialResource) createBreakGlassCredential(ctx context.Co
 	// Get the created break glass credential which includes the kubeconfig
 	pollCtx, cancel := context.WithTimeout(ctx, 1*time.Minute)
 	defer cancel()
-	pollResp, err := b.collection.Cluster(state.Cluster.ValueString()).BreakGlassCredentials().BreakGlassCredential(resp.Body().ID()).
+	pollResp, err := collection.Cluster(state.Cluster.ValueString()).BreakGlassCredentials().BreakGlassCredential(resp.Body().ID()).
 		Poll().Interval(5 * time.Second).Predicate(
 		func(response *cmv1.BreakGlassCredentialGetResponse) bool {
 			return response.Body().Kubeconfig() != ""
@@ -308,11 +320,22 @@ func (b *BreakGlassCredentialResource) createBreakGlassCredential(ctx context.Co
 		return err
 	}
 
-	b.populateState(pollResp.Body(), state)
+	populateState(pollResp.Body(), state)
+	return nil
+}
+
+// Revokes the break glass credentials of the cluster. OCM doesn't support
+// revoking a single credential, so all the credentials issued for the cluster
+// are revoked
+func revokeBreakGlassCredentials(ctx context.Context, collection *cmv1.ClustersClient, clusterId string) error {
+	resp, err := collection.Cluster(clusterId).BreakGlassCredentials().Delete().SendContext(ctx)
+	if err != nil {
+		return common.HandleErr(resp.Error(), err)
+	}
 	return nil
 }
 
-func (b *BreakGlassCredentialResource) populateState(credential *cmv1.BreakGlassCredential, state *BreakGlassCredential) {
+func populateState(credential *cmv1.BreakGlassCredential, state *BreakGlassCredential) {
 	if state == nil {
 		state = &BreakGlassCredential{}
 	}
@@ -27,3 +27,8 @@ type BreakGlassCredential struct {
 	Status              types.String `tfsdk:"status"`
 	Kubeconfig          types.String `tfsdk:"kubeconfig"`
 }
+
+type BreakGlassCredentialEphemeral struct {
+	BreakGlassCredential
+	RevokeOnClose types.Bool `tfsdk:"revoke_on_close"`
+}
@@ -24,7 +24,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
 	rosa "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common"
@@ -43,6 +42,7 @@ type ClusterRosaClassicDatasource struct {
 	clusterCollection *cmv1.ClustersClient
 	versionCollection *cmv1.VersionsClient
 	clusterWait       common.ClusterWait
+	defaultProperties map[string]string
 }
 
 func NewDataSource() datasource.DataSource {
@@ -95,6 +95,11 @@ func (r *ClusterRosaClassicDatasource) Schema(ctx context.Context, req datasourc
 					"Site Reliability Engineer (SRE) platform metrics.",
 				Computed: true,
 			},
+			"node_drain_grace_period": schema.Int64Attribute{
+				Description: "Grace period in whole minutes during which pod disruption budgets are respected " +
+					"while nodes are drained during upgrades.",
+				Computed: true,
+			},
 			"delete_protection": rosa.DeleteProtectionDatasourceSchema(),
 			"disable_scp_checks": schema.BoolAttribute{
 				Description: "Indicates if cloud permission checks are disabled when attempting installation of the cluster. " +
@@ -116,6 +121,11 @@ func (r *ClusterRosaClassicDatasource) Schema(ctx context.Context, req datasourc
 				ElementType: types.StringType,
 				Computed:    true,
 			},
+			"tags_all": schema.MapAttribute{
+				Description: "All the tags applied to the cluster resources created in AWS, including the default tags of the provider.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			"ccs_enabled": schema.BoolAttribute{
 				Description: "Enables customer cloud subscription (Immutable with ROSA)",
 				Computed:    true,
@@ -275,10 +285,26 @@ func (r *ClusterRosaClassicDatasource) Schema(ctx context.Context, req datasourc
 				Description: deprecatedMessage,
 				Computed:    true,
 			},
+			"upgrade_next_run": schema.StringAttribute{
+				Description: deprecatedMessage,
+				Computed:    true,
+			},
+			"upgrade_schedule": schema.StringAttribute{
+				Description: deprecatedMessage,
+				Computed:    true,
+			},
 			"create_admin_user": schema.BoolAttribute{
 				Description: deprecatedMessage,
 				Computed:    true,
 			},
+			"admin_password_wo": schema.StringAttribute{
+				Description: deprecatedMessage,
+				Computed:    true,
+			},
+			"admin_password_wo_version": schema.Int64Attribute{
+				Description: deprecatedMessage,
+				Computed:    true,
+			},
 			"admin_credentials": schema.SingleNestedAttribute{
 				Description: deprecatedMessage,
 				Attributes: map[string]schema.Attribute{
@@ -298,6 +324,10 @@ func (r *ClusterRosaClassicDatasource) Schema(ctx context.Context, req datasourc
 				Description: deprecatedMessage,
 				Computed:    true,
 			},
+			"wait_for_upgrade_complete": schema.BoolAttribute{
+				Description: deprecatedMessage,
+				Computed:    true,
+			},
 			"autoscaling_enabled": schema.BoolAttribute{
 				Description: deprecatedMessage,
 				Computed:    true,
@@ -331,6 +361,7 @@ func (r *ClusterRosaClassicDatasource) Schema(ctx context.Context, req datasourc
 				Description: deprecatedMessage,
 				Computed:    true,
 			},
+			"timeouts": common.DataSourceTimeoutsAttribute(timeoutsOpts, deprecatedMessage),
 		},
 	}
 }
@@ -341,18 +372,21 @@ func (r *ClusterRosaClassicDatasource) Configure(ctx context.Context, req dataso
 		return
 	}
 
-	connection, ok := req.ProviderData.(*sdk.Connection)
+	providerData, ok := req.ProviderData.(*common.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.Connaction, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
+	connection := providerData.Connection
 
 	r.clusterCollection = connection.ClustersMgmt().V1().Clusters()
 	r.versionCollection = connection.ClustersMgmt().V1().Versions()
-	r.clusterWait = common.NewClusterWait(r.clusterCollection, connection)
+	r.clusterWait = common.NewClusterWait(r.clusterCollection, connection,
+		common.WithPollingInterval(providerData.ClusterWaitPollingInterval))
+	r.defaultProperties = providerData.DefaultProperties
 }
 
 func (r *ClusterRosaClassicDatasource) Read(ctx context.Context, request datasource.ReadRequest,
@@ -389,7 +423,7 @@ func (r *ClusterRosaClassicDatasource) Read(ctx context.Context, request datasou
 	object := get.Body()
 
 	// Save the state:
-	err = populateRosaClassicClusterState(ctx, object, state, common.DefaultHttpClient{})
+	err = populateRosaClassicClusterState(ctx, object, state, common.DefaultHttpClient{}, r.defaultProperties)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Can't populate cluster state",
@@ -406,9 +440,14 @@ func (r *ClusterRosaClassicDatasource) Read(ctx context.Context, request datasou
 	state.Version = types.StringNull()
 	state.DestroyTimeout = types.Int64Null()
 	state.UpgradeAcksFor = types.StringNull()
+	state.UpgradeNextRun = types.StringNull()
+	state.UpgradeSchedule = types.StringNull()
 	state.CreateAdminUser = types.BoolNull()
 	state.AdminCredentials = rosaTypes.AdminCredentialsNull()
+	state.AdminPasswordWo = types.StringNull()
+	state.AdminPasswordWoVersion = types.Int64Null()
 	state.WaitForCreateComplete = types.BoolNull()
+	state.WaitForUpgradeComplete = types.BoolNull()
 	state.AutoScalingEnabled = types.BoolNull()
 	state.MinReplicas = types.Int64Null()
 	state.MaxReplicas = types.Int64Null()
@@ -416,6 +455,7 @@ func (r *ClusterRosaClassicDatasource) Read(ctx context.Context, request datasou
 	state.ComputeMachineType = types.StringNull()
 	state.WorkerDiskSize = types.Int64Null()
 	state.DefaultMPLabels = types.MapNull(types.StringType)
+	state.Timeouts = common.TimeoutsNull(timeoutsOpts)
 
 	clusterClient := r.clusterCollection.Cluster(state.ID.ValueString())
 	dpVal, dpDiags := rosa.ResolveDeleteProtection(ctx, clusterClient, object)
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"math"
 	"net/http"
 	"os"
 	"reflect"
@@ -29,6 +30,8 @@ import (
 
 	awsarn "github.com/aws/aws-sdk-go-v2/aws/arn"
 	semver "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
@@ -44,6 +47,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	idputils "github.com/openshift-online/ocm-common/pkg/idp/utils"
@@ -51,7 +55,6 @@ import (
 	ocmUtils "github.com/openshift-online/ocm-common/pkg/ocm/utils"
 	"github.com/openshift-online/ocm-common/pkg/rosa/oidcconfigs"
 	commonutils "github.com/openshift-online/ocm-common/pkg/utils"
-	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	ocm_errors "github.com/openshift-online/ocm-sdk-go/errors"
 
@@ -62,6 +65,7 @@ import (
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/sts"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common/attrvalidators"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common/planmodifiers"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/identityprovider"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/proxy"
 )
@@ -70,6 +74,12 @@ const (
 	// FIXME: This should be coming from the API or only validate at the API level
 	MinVersion          = "4.10.0"
 	lowestHttpTokensVer = "4.11.0"
+
+	// Same bounds as the ROSA CLI, one week
+	maxNodeDrainGracePeriodMinutes = 10080
+	// OCM rejects upgrade policies scheduled less than 5 minutes in the future
+	minUpgradeNextRunDelay   = 5 * time.Minute
+	nodeDrainGracePeriodUnit = "minutes"
 )
 
 type ClusterRosaClassicResource struct {
@@ -78,6 +88,9 @@ type ClusterRosaClassicResource struct {
 
 var _ resource.ResourceWithConfigure = &ClusterRosaClassicResource{}
 var _ resource.ResourceWithImportState = &ClusterRosaClassicResource{}
+var _ resource.ResourceWithModifyPlan = &ClusterRosaClassicResource{}
+
+var timeoutsOpts = timeouts.Opts{Create: true, Update: true, Delete: true}
 
 func New() resource.Resource {
 	return &ClusterRosaClassicResource{}
@@ -126,6 +139,8 @@ func (r *ClusterRosaClassicResource) Schema(ctx context.Context, req resource.Sc
 				},
 				Validators: []validator.String{
 					stringvalidator.LengthAtMost(rosa.MaxClusterDomainPrefixLength),
+					stringvalidator.RegexMatches(rosa.DomainPrefixRE, "domain prefix must consist of lower case "+
+						"alphanumeric characters or '-', start with a letter and end with an alphanumeric character"),
 				},
 			},
 			"cloud_region": schema.StringAttribute{
@@ -165,7 +180,7 @@ func (r *ClusterRosaClassicResource) Schema(ctx context.Context, req resource.Sc
 				Validators:  []validator.Map{rosa.PropertiesValidator},
 			},
 			"ocm_properties": schema.MapAttribute{
-				Description: "Merged properties defined by OCM and the user defined 'properties'.",
+				Description: "Merged properties defined by OCM, the default properties of the provider and the user defined 'properties'.",
 				ElementType: types.StringType,
 				Computed:    true,
 			},
@@ -174,6 +189,11 @@ func (r *ClusterRosaClassicResource) Schema(ctx context.Context, req resource.Sc
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"tags_all": schema.MapAttribute{
+				Description: "All the tags applied to the cluster resources created in AWS, including the default tags of the provider.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			"ccs_enabled": schema.BoolAttribute{
 				Description: "Enables customer cloud subscription (Immutable with ROSA)",
 				Computed:    true,
@@ -389,7 +409,8 @@ func (r *ClusterRosaClassicResource) Schema(ctx context.Context, req resource.Sc
 					"and the resource remains in Terraform state so dependent STS resources (IAM roles, OIDC provider) " +
 					"are not removed while OCM uninstall may still be in progress. " +
 					"Set `disable_waiting_in_destroy = true` to skip this wait entirely.",
-				Optional: true,
+				DeprecationMessage: common.TimeoutDeprecationMessage("delete"),
+				Optional:           true,
 			},
 			"state": schema.StringAttribute{
 				Description: "State of the cluster.",
@@ -413,6 +434,39 @@ func (r *ClusterRosaClassicResource) Schema(ctx context.Context, req resource.Sc
 					"upgrade to OpenShift 4.12.z from 4.11 or before).",
 				Optional: true,
 			},
+			"upgrade_next_run": schema.StringAttribute{
+				Description: "Start time, in RFC3339 format, of the upgrade scheduled when `version` is increased, " +
+					"for example \"2026-11-07T22:00:00Z\" to land the upgrade inside an approved maintenance window. " +
+					"If not set, the upgrade starts 10 minutes after the apply. Changing it reschedules a pending upgrade. " +
+					"A time kept from a previous upgrade that has already passed is ignored, with a warning.",
+				Optional: true,
+				Validators: []validator.String{
+					attrvalidators.RFC3339TimestampValidator(),
+				},
+			},
+			"upgrade_schedule": schema.StringAttribute{
+				Description: "Cron expression, in UTC, of a recurring automatic upgrade policy, for example \"0 22 * * 6\" " +
+					"to upgrade the cluster to the latest patch version every Saturday at 22:00. " +
+					"Removing it cancels the recurring policy. Version upgrades can't be requested with `version` " +
+					"while it is set, and it requires `wait_for_create_complete` when creating the cluster.",
+				Optional: true,
+				Validators: []validator.String{
+					attrvalidators.CronValidator(),
+				},
+			},
+			"node_drain_grace_period": schema.Int64Attribute{
+				Description: "Grace period in whole minutes during which pod disruption budgets are respected " +
+					"while nodes are drained during upgrades. Valid range is 0-10080 minutes (one week).",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+					int64validator.AtMost(maxNodeDrainGracePeriodMinutes),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 			"create_admin_user": schema.BoolAttribute{
 				Description: "Indicates if create cluster admin user. Set it true to create cluster admin user with default username `cluster-admin` " +
 					"and generated password. It will be ignored if `admin_credentials` is set." + common.ValueCannotBeChangedStringDescription,
@@ -431,7 +485,7 @@ func (r *ClusterRosaClassicResource) Schema(ctx context.Context, req resource.Sc
 						},
 					},
 					"password": schema.StringAttribute{
-						Description: "Admin password that will be created with the cluster.",
+						Description: "Admin password that will be created with the cluster. It is removed from the state when `admin_password_wo` is set.",
 						Optional:    true,
 						Computed:    true,
 						Sensitive:   true,
@@ -447,6 +501,8 @@ func (r *ClusterRosaClassicResource) Schema(ctx context.Context, req resource.Sc
 					objectplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"admin_password_wo":         rosa.AdminPasswordWriteOnlyResourceSchema(),
+			"admin_password_wo_version": rosa.AdminPasswordWriteOnlyVersionResourceSchema(),
 			"private_hosted_zone": schema.SingleNestedAttribute{
 				Description: "Used in a shared VPC topology. HostedZone attributes. " + common.ValueCannotBeChangedStringDescription,
 				Attributes: map[string]schema.Attribute{
@@ -475,11 +531,21 @@ func (r *ClusterRosaClassicResource) Schema(ctx context.Context, req resource.Sc
 				Description: "Wait until the cluster is either in a ready state or in an error state. The waiter has a timeout of 60 minutes, with the default value set to false",
 				Optional:    true,
 			},
+			"wait_for_upgrade_complete": schema.BoolAttribute{
+				Description: "Wait until the upgrade scheduled by a change of `version` is completed or has failed. The waiter " +
+					"uses the `update` timeout of the `timeouts` block, which defaults to 4 hours, with the default value " +
+					"set to false.",
+				Optional: true,
+			},
 			"max_cluster_wait_timeout_in_minutes": schema.Int64Attribute{
-				Description: "This value sets the maximum duration in minutes to wait for the cluster to be in a ready state.",
-				Optional:    true,
+				Description:        "This value sets the maximum duration in minutes to wait for the cluster to be in a ready state.",
+				DeprecationMessage: common.TimeoutDeprecationMessage("create"),
+				Optional:           true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeoutsOpts),
+		},
 	}
 }
 
@@ -489,6 +555,10 @@ func (r *ClusterRosaClassicResource) ConfigValidators(context.Context) []resourc
 			path.MatchRoot("channel"),
 			path.MatchRoot("channel_group"),
 		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("upgrade_next_run"),
+			path.MatchRoot("upgrade_schedule"),
+		),
 	}
 }
 
@@ -498,18 +568,37 @@ func (r *ClusterRosaClassicResource) Configure(ctx context.Context, req resource
 		return
 	}
 
-	connection, ok := req.ProviderData.(*sdk.Connection)
+	providerData, ok := req.ProviderData.(*common.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.Connaction, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
+	connection := providerData.Connection
 
 	r.ClusterCollection = connection.ClustersMgmt().V1().Clusters()
 	r.VersionCollection = connection.ClustersMgmt().V1().Versions()
-	r.ClusterWait = common.NewClusterWait(r.ClusterCollection, connection)
+	r.ClusterWait = common.NewClusterWait(r.ClusterCollection, connection,
+		common.WithPollingInterval(providerData.ClusterWaitPollingInterval))
+	r.PollingInterval = providerData.ClusterWaitPollingInterval
+	r.DefaultTags = providerData.DefaultTags
+	r.DefaultProperties = providerData.DefaultProperties
+}
+
+func (r *ClusterRosaClassicResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	rosa.PlanAdminPasswordWriteOnly(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The defaults come from the configuration of the provider, so wait till it is configured:
+	if r.ClusterCollection == nil {
+		return
+	}
+	planmodifiers.MergeDefaultTags(ctx, req, resp, path.Root("tags"), path.Root("tags_all"), r.DefaultTags)
+	planmodifiers.ApplyDefaultProperties(ctx, req, resp, path.Root("ocm_properties"), r.DefaultProperties)
 }
 
 const (
@@ -517,7 +606,7 @@ const (
 )
 
 func createClassicClusterObject(ctx context.Context,
-	state *ClusterRosaClassicState, diags diag.Diagnostics) (*cmv1.Cluster, error) {
+	state *ClusterRosaClassicState, diags diag.Diagnostics, defaultProperties map[string]string) (*cmv1.Cluster, error) {
 
 	ocmClusterResource := ocmr.NewCluster()
 	builder := ocmClusterResource.GetClusterBuilder()
@@ -550,6 +639,7 @@ func createClassicClusterObject(ctx context.Context,
 	// Set default properties
 	properties := make(map[string]string)
 	maps.Copy(properties, rosa.OCMProperties)
+	maps.Copy(properties, defaultProperties)
 	if common.HasValue(state.Properties) {
 		propertiesElements, err := common.OptionalMap(ctx, state.Properties)
 		if err != nil {
@@ -600,6 +690,12 @@ func createClassicClusterObject(ctx context.Context,
 		builder.DisableUserWorkloadMonitoring(state.DisableWorkloadMonitoring.ValueBool())
 	}
 
+	if common.HasValue(state.NodeDrainGracePeriod) {
+		builder.NodeDrainGracePeriod(cmv1.NewValue().
+			Value(float64(state.NodeDrainGracePeriod.ValueInt64())).
+			Unit(nodeDrainGracePeriodUnit))
+	}
+
 	if !common.IsStringAttributeUnknownOrEmpty(state.BaseDNSDomain) {
 		dnsBuilder := cmv1.NewDNS()
 		dnsBuilder.BaseDomain(state.BaseDNSDomain.ValueString())
@@ -675,7 +771,7 @@ func createClassicClusterObject(ctx context.Context,
 			common.OptionalString(state.Sts.TrustPolicyExternalID))
 	}
 
-	awsTags, err := common.OptionalMap(ctx, state.Tags)
+	awsTags, err := common.OptionalMap(ctx, state.TagsAll)
 	if err != nil {
 		return nil, err
 	}
@@ -787,11 +883,15 @@ func createClassicClusterObject(ctx context.Context,
 	}
 
 	username, password := rosaTypes.ExpandAdminCredentials(ctx, state.AdminCredentials, diags)
-	if common.BoolWithFalseDefault(state.CreateAdminUser) || common.HasValue(state.AdminCredentials) {
+	passwordWriteOnly := state.AdminPasswordWo.ValueString()
+	if common.BoolWithFalseDefault(state.CreateAdminUser) || common.HasValue(state.AdminCredentials) ||
+		passwordWriteOnly != "" {
 		if username == "" {
 			username = commonutils.ClusterAdminUsername
 		}
-		if password == "" {
+		if passwordWriteOnly != "" {
+			password = passwordWriteOnly
+		} else if password == "" {
 			password, err = idputils.GenerateRandomPassword()
 			if err != nil {
 				tflog.Error(ctx, "Failed to generate random password")
@@ -814,7 +914,12 @@ func createClassicClusterObject(ctx context.Context,
 		htPasswdIDP := cmv1.NewHTPasswdIdentityProvider().Users(htpassUserList)
 		builder.Htpasswd(htPasswdIDP)
 	}
-	state.AdminCredentials = rosaTypes.FlattenAdminCredentials(username, password)
+	if passwordWriteOnly != "" {
+		// Write-only passwords must not be stored in the state
+		state.AdminCredentials = rosaTypes.FlattenAdminCredentials(username, "")
+	} else {
+		state.AdminCredentials = rosaTypes.FlattenAdminCredentials(username, password)
+	}
 
 	builder, err = proxy.BuildProxy(state.Proxy, builder)
 	if err != nil {
@@ -867,9 +972,24 @@ func (r *ClusterRosaClassicResource) Create(ctx context.Context, request resourc
 	if response.Diagnostics.HasError() {
 		return
 	}
+	state.AdminPasswordWo, diags = rosa.AdminPasswordWriteOnlyFromConfig(ctx, request.Config)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 	enableDeleteProtection := common.HasValue(state.DeleteProtection) && state.DeleteProtection.ValueBool()
 	summary := "Can't build cluster"
 
+	// Recurring upgrade policies can only be created once the cluster is ready
+	waitForCreateComplete := common.HasValue(state.WaitForCreateComplete) && state.WaitForCreateComplete.ValueBool()
+	if common.HasValue(state.UpgradeSchedule) && !waitForCreateComplete {
+		response.Diagnostics.AddError(
+			summary,
+			"Attribute 'upgrade_schedule' requires 'wait_for_create_complete' to be set to true when creating a cluster",
+		)
+		return
+	}
+
 	// In case version with "openshift-v" prefix was used here,
 	// Give a meaningful message to inform the user that it not supported any more
 	if common.HasValue(state.Version) && strings.HasPrefix(state.Version.ValueString(), rosa.VersionPrefix) {
@@ -941,7 +1061,8 @@ func (r *ClusterRosaClassicResource) Create(ctx context.Context, request resourc
 		}
 	}
 
-	object, err := createClassicClusterObject(ctx, state, diags)
+	object, err := createClassicClusterObject(ctx, state, diags, r.DefaultProperties)
+	state.AdminPasswordWo = types.StringNull()
 	if err != nil {
 		response.Diagnostics.AddError(
 			summary,
@@ -967,7 +1088,7 @@ func (r *ClusterRosaClassicResource) Create(ctx context.Context, request resourc
 	object = add.Body()
 
 	// Save initial state:
-	err = populateRosaClassicClusterState(ctx, object, state, common.DefaultHttpClient{})
+	err = populateRosaClassicClusterState(ctx, object, state, common.DefaultHttpClient{}, r.DefaultProperties)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Can't populate cluster state",
@@ -986,13 +1107,24 @@ func (r *ClusterRosaClassicResource) Create(ctx context.Context, request resourc
 				"Waiting for cluster creation finished with error",
 				fmt.Sprintf("Waiting for cluster creation finished with the error %v", err),
 			)
+			diags = response.State.Set(ctx, state)
+			response.Diagnostics.Append(diags...)
+			return
 		}
-		object, err = r.ClusterWait.WaitForClusterToBeReady(ctx, object.ID(), *timeOut)
+		createTimeout, diags := state.Timeouts.Create(ctx, time.Duration(*timeOut)*time.Minute)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			diags = response.State.Set(ctx, state)
+			response.Diagnostics.Append(diags...)
+			return
+		}
+		object, err = r.ClusterWait.WaitForClusterToBeReady(ctx, object.ID(), common.DurationToMinutes(createTimeout))
 		if err != nil {
 			response.Diagnostics.AddError(
 				"Waiting for cluster creation finished with error",
 				fmt.Sprintf("Waiting for cluster creation finished with the error %v", err),
 			)
+			response.Diagnostics.Append(common.ClusterWaitDiagnostics(err)...)
 			if object == nil {
 				diags = response.State.Set(ctx, state)
 				response.Diagnostics.Append(diags...)
@@ -1002,7 +1134,7 @@ func (r *ClusterRosaClassicResource) Create(ctx context.Context, request resourc
 	}
 
 	// Save the state post wait completion:
-	err = populateRosaClassicClusterState(ctx, object, state, common.DefaultHttpClient{})
+	err = populateRosaClassicClusterState(ctx, object, state, common.DefaultHttpClient{}, r.DefaultProperties)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Can't populate cluster state",
@@ -1013,6 +1145,24 @@ func (r *ClusterRosaClassicResource) Create(ctx context.Context, request resourc
 		return
 	}
 
+	if common.HasValue(state.UpgradeSchedule) && object.State() == cmv1.ClusterStateReady {
+		upgradeSchedule := state.UpgradeSchedule
+		state.UpgradeSchedule = types.StringNull()
+		err = r.reconcileUpgradeSchedule(ctx, state, &ClusterRosaClassicState{UpgradeSchedule: upgradeSchedule})
+		if err != nil {
+			response.Diagnostics.AddError(
+				"Can't create upgrade schedule",
+				fmt.Sprintf(
+					"Cluster '%s' was created but its recurring upgrade policy could not be created: %v",
+					state.ID.ValueString(), err,
+				),
+			)
+			diags = response.State.Set(ctx, state)
+			response.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
 	if enableDeleteProtection {
 		clusterClient := r.ClusterCollection.Cluster(state.ID.ValueString())
 		err = rosa.UpdateDeleteProtection(ctx, clusterClient, true)
@@ -1075,7 +1225,7 @@ func (r *ClusterRosaClassicResource) Read(ctx context.Context, request resource.
 	object := get.Body()
 
 	// Save the state:
-	err = populateRosaClassicClusterState(ctx, object, state, common.DefaultHttpClient{})
+	err = populateRosaClassicClusterState(ctx, object, state, common.DefaultHttpClient{}, r.DefaultProperties)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Can't populate cluster state",
@@ -1086,6 +1236,26 @@ func (r *ClusterRosaClassicResource) Read(ctx context.Context, request resource.
 		return
 	}
 
+	// Only look for the recurring upgrade policy when it is managed, so that
+	// drift is detected without listing the policies of every cluster
+	if common.HasValue(state.UpgradeSchedule) {
+		upgrades, err := upgrade.GetScheduledUpgrades(ctx, r.ClusterCollection, state.ID.ValueString())
+		if err != nil {
+			response.Diagnostics.AddError(
+				"Can't get upgrade policies",
+				fmt.Sprintf(
+					"Can't get upgrade policies of cluster with identifier '%s': %v",
+					state.ID.ValueString(), err,
+				),
+			)
+			return
+		}
+		state.UpgradeSchedule = types.StringNull()
+		if recurring := upgrade.FindRecurringUpgrade(upgrades); recurring != nil {
+			state.UpgradeSchedule = types.StringValue(recurring.Schedule())
+		}
+	}
+
 	clusterClient := r.ClusterCollection.Cluster(state.ID.ValueString())
 	priorDeleteProtection := state.DeleteProtection
 	dpVal, dpDiags := rosa.ResolveDeleteProtection(ctx, clusterClient, object)
@@ -1096,6 +1266,9 @@ func (r *ClusterRosaClassicResource) Read(ctx context.Context, request resource.
 		state.DeleteProtection = dpVal
 	}
 
+	// Warn about limited support, so that it is noticed when planning:
+	response.Diagnostics.Append(common.LimitedSupportWarnings(ctx, r.ClusterCollection, object)...)
+
 	diags = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diags...)
 }
@@ -1266,6 +1439,17 @@ func (r *ClusterRosaClassicResource) Update(ctx context.Context, request resourc
 		return
 	}
 
+	// The admin password isn't kept in the state once the write-only one is used, as planned by
+	// ModifyPlan, so that isn't a change of the admin credentials:
+	passwordWriteOnly, diags := rosa.AdminPasswordWriteOnlyFromConfig(ctx, request.Config)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if !passwordWriteOnly.IsNull() {
+		state.AdminCredentials = rosaTypes.AdminCredentialsWithoutPassword(ctx, state.AdminCredentials)
+	}
+
 	//assert no changes on specific attributes
 	diags = validateNoImmutableAttChange(state, plan)
 	if diags.HasError() {
@@ -1301,8 +1485,18 @@ func (r *ClusterRosaClassicResource) Update(ctx context.Context, request resourc
 		return
 	}
 
+	// Reconcile the recurring upgrade policy first, OCM doesn't accept pinned
+	// upgrades while a recurring one exists
+	if err := r.reconcileUpgradeSchedule(ctx, state, plan); err != nil {
+		response.Diagnostics.AddError(
+			"Can't update upgrade schedule",
+			fmt.Sprintf("Can't update upgrade schedule of cluster with identifier: `%s`, %v", state.ID.ValueString(), err),
+		)
+		return
+	}
+
 	// Schedule a cluster upgrade if a newer version is requested
-	if err := r.upgradeClusterIfNeeded(ctx, state, plan); err != nil {
+	if err := r.upgradeClusterIfNeeded(ctx, state, plan, &response.Diagnostics); err != nil {
 		response.Diagnostics.AddError(
 			"Can't upgrade cluster",
 			fmt.Sprintf("Can't upgrade cluster version with identifier: `%s`, %v", state.ID.ValueString(), err),
@@ -1310,6 +1504,17 @@ func (r *ClusterRosaClassicResource) Update(ctx context.Context, request resourc
 		return
 	}
 
+	if rosa.ShouldRotateAdminPassword(state.AdminPasswordWoVersion, plan.AdminPasswordWoVersion) {
+		if err := r.rotateAdminPassword(ctx, state, request.Config); err != nil {
+			response.Diagnostics.AddError(
+				"Can't rotate admin password",
+				fmt.Sprintf("Can't rotate admin password of cluster with identifier: `%s`, %v", state.ID.ValueString(), err),
+			)
+			return
+		}
+		state.AdminPasswordWoVersion = plan.AdminPasswordWoVersion
+	}
+
 	_, shouldPatchDeleteProtection := common.ShouldPatchBool(state.DeleteProtection, plan.DeleteProtection)
 	desiredDeleteProtection := plan.DeleteProtection
 	if shouldPatchDeleteProtection {
@@ -1379,7 +1584,13 @@ func (r *ClusterRosaClassicResource) Update(ctx context.Context, request resourc
 		clusterBuilder.DisableUserWorkloadMonitoring(plan.DisableWorkloadMonitoring.ValueBool())
 	}
 
-	patchProperties := shouldPatchProperties(state, plan)
+	if nodeDrainGracePeriod, ok := common.ShouldPatchInt(state.NodeDrainGracePeriod, plan.NodeDrainGracePeriod); ok {
+		clusterBuilder.NodeDrainGracePeriod(cmv1.NewValue().
+			Value(float64(nodeDrainGracePeriod)).
+			Unit(nodeDrainGracePeriodUnit))
+	}
+
+	patchProperties := shouldPatchProperties(state, plan, r.DefaultProperties)
 	if patchProperties {
 		propertiesElements, err := rosa.ValidatePatchProperties(ctx, state.Properties, plan.Properties)
 		if err != nil {
@@ -1388,7 +1599,22 @@ func (r *ClusterRosaClassicResource) Update(ctx context.Context, request resourc
 				fmt.Sprintf("Shouldn't patch cluster with identifier: '%s', %v", state.ID.ValueString(), err),
 			)
 		}
+		if propertiesElements == nil && rosa.DefaultPropertiesMissing(ctx, state.OCMProperties, r.DefaultProperties) {
+			// Only the default properties changed, so keep the ones of the user:
+			propertiesElements, err = common.OptionalMap(ctx, state.Properties)
+			if err != nil {
+				response.Diagnostics.AddError(
+					"Can't patch cluster",
+					fmt.Sprintf("Can't read properties of cluster with identifier: '%s', %v", state.ID.ValueString(), err),
+				)
+				return
+			}
+			if propertiesElements == nil {
+				propertiesElements = map[string]string{}
+			}
+		}
 		if propertiesElements != nil {
+			propertiesElements = common.MergeDefaults(r.DefaultProperties, propertiesElements)
 			maps.Copy(propertiesElements, rosa.OCMProperties)
 			clusterBuilder.Properties(propertiesElements)
 		}
@@ -1423,7 +1649,7 @@ func (r *ClusterRosaClassicResource) Update(ctx context.Context, request resourc
 	object := update.Body()
 
 	// Update the state:
-	err = populateRosaClassicClusterState(ctx, object, plan, common.DefaultHttpClient{})
+	err = populateRosaClassicClusterState(ctx, object, plan, common.DefaultHttpClient{}, r.DefaultProperties)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Can't populate cluster state",
@@ -1454,7 +1680,8 @@ func (r *ClusterRosaClassicResource) Update(ctx context.Context, request resourc
 
 // Upgrades the cluster if the desired (plan) version is greater than the
 // current version
-func (r *ClusterRosaClassicResource) upgradeClusterIfNeeded(ctx context.Context, state, plan *ClusterRosaClassicState) error {
+func (r *ClusterRosaClassicResource) upgradeClusterIfNeeded(ctx context.Context, state, plan *ClusterRosaClassicState,
+	diags *diag.Diagnostics) error {
 	if common.IsStringAttributeUnknownOrEmpty(plan.Version) || common.IsStringAttributeUnknownOrEmpty(state.CurrentVersion) {
 		// No version information, nothing to do
 		tflog.Debug(ctx, "Insufficient cluster version information to determine if upgrade should be performed.")
@@ -1501,19 +1728,37 @@ func (r *ClusterRosaClassicResource) upgradeClusterIfNeeded(ctx context.Context,
 	cancelingUpgradeOnly := desiredVersion.Equal(currentVersion)
 
 	if !cancelingUpgradeOnly {
+		if common.HasValue(plan.UpgradeSchedule) {
+			return fmt.Errorf("the cluster is upgraded by the recurring upgrade policy set in 'upgrade_schedule', " +
+				"remove it to request an upgrade to a specific version")
+		}
 		if err = r.validateUpgrade(ctx, state, plan); err != nil {
 			return err
 		}
 	}
 
+	nextRun, err := upgradeNextRun(state, plan)
+	if err != nil {
+		return err
+	}
+	if nextRun == nil && common.HasValue(plan.UpgradeNextRun) && !cancelingUpgradeOnly {
+		diags.AddWarning(
+			"Ignoring past upgrade_next_run",
+			fmt.Sprintf("The 'upgrade_next_run' time '%s' of cluster '%s' has already passed, so the upgrade to "+
+				"version '%s' is scheduled as if it wasn't set. Set 'upgrade_next_run' to a future time to schedule "+
+				"it in a maintenance window.", plan.UpgradeNextRun.ValueString(), state.ID.ValueString(), desiredVersion),
+		)
+	}
+
 	// Fetch existing upgrade policies
 	upgrades, err := upgrade.GetScheduledUpgrades(ctx, r.ClusterCollection, state.ID.ValueString())
 	if err != nil {
 		return fmt.Errorf("failed to get upgrade policies: %v", err)
 	}
 
-	// Stop if an upgrade is already in progress
-	correctUpgradePending, err := upgrade.CheckAndCancelUpgrades(ctx, r.ClusterCollection, upgrades, desiredVersion)
+	// Stop if an upgrade is already in progress, pending upgrades outside of
+	// the requested window are cancelled so they can be rescheduled
+	correctUpgradePending, err := upgrade.CheckAndCancelUpgradesAt(ctx, r.ClusterCollection, upgrades, desiredVersion, nextRun)
 	if err != nil {
 		return err
 	}
@@ -1521,13 +1766,86 @@ func (r *ClusterRosaClassicResource) upgradeClusterIfNeeded(ctx context.Context,
 	// Schedule a new upgrade
 	if !correctUpgradePending && !cancelingUpgradeOnly {
 		ackString := plan.UpgradeAcksFor.ValueString()
-		if err = scheduleUpgrade(ctx, r.ClusterCollection, state.ID.ValueString(), desiredVersion, ackString); err != nil {
+		if err = scheduleUpgrade(ctx, r.ClusterCollection, state.ID.ValueString(), desiredVersion, ackString, nextRun); err != nil {
+			return err
+		}
+	}
+
+	// Wait for the upgrade to finish if requested, which includes waiting for
+	// the requested next run
+	if !cancelingUpgradeOnly && common.BoolWithFalseDefault(plan.WaitForUpgradeComplete) {
+		upgradeTimeout, diags := plan.Timeouts.Update(ctx, common.DefaultUpgradeTimeout)
+		if diags.HasError() {
+			return fmt.Errorf("failed to get the update timeout: %v", diags.Errors())
+		}
+		err = common.WaitForUpgrade(ctx, fmt.Sprintf("cluster '%s'", state.ID.ValueString()), upgradeTimeout,
+			r.PollingInterval, func(ctx context.Context) (*common.UpgradeProgress, error) {
+				return upgrade.GetUpgradeProgress(ctx, r.ClusterCollection, state.ID.ValueString(), desiredVersion)
+			})
+		if err != nil {
 			return err
 		}
 	}
 
 	state.Version = plan.Version
 	state.UpgradeAcksFor = plan.UpgradeAcksFor
+	state.UpgradeNextRun = plan.UpgradeNextRun
+	return nil
+}
+
+// Returns the start time of the upgrade requested with 'upgrade_next_run'. A
+// time that was kept from a previous apply and has passed since then is
+// ignored, so that it doesn't block the following version upgrades.
+func upgradeNextRun(state, plan *ClusterRosaClassicState) (*time.Time, error) {
+	if !common.HasValue(plan.UpgradeNextRun) {
+		return nil, nil
+	}
+	nextRun, err := time.Parse(time.RFC3339, plan.UpgradeNextRun.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse upgrade next run: %v", err)
+	}
+	nextRun = nextRun.UTC()
+	if state.UpgradeNextRun.Equal(plan.UpgradeNextRun) &&
+		nextRun.Before(time.Now().UTC().Add(minUpgradeNextRunDelay)) {
+		return nil, nil
+	}
+	return &nextRun, nil
+}
+
+// Sets the password of the cluster admin user to the write-only password of
+// the configuration
+func (r *ClusterRosaClassicResource) rotateAdminPassword(ctx context.Context, state *ClusterRosaClassicState,
+	config tfsdk.Config) error {
+	password, diags := rosa.AdminPasswordWriteOnlyFromConfig(ctx, config)
+	if diags.HasError() {
+		return fmt.Errorf("failed to read '%s': %v", rosa.AdminPasswordWriteOnlyAttribute, diags.Errors())
+	}
+	username, _ := rosaTypes.ExpandAdminCredentials(ctx, state.AdminCredentials, diags)
+	if username == "" {
+		username = commonutils.ClusterAdminUsername
+	}
+	return rosa.RotateAdminPassword(ctx, r.ClusterCollection.Cluster(state.ID.ValueString()),
+		username, password.ValueString())
+}
+
+// Creates, reschedules or cancels the recurring upgrade policy of the cluster
+// when the configured schedule changes
+func (r *ClusterRosaClassicResource) reconcileUpgradeSchedule(ctx context.Context, state, plan *ClusterRosaClassicState) error {
+	if plan.UpgradeSchedule.IsUnknown() || state.UpgradeSchedule.Equal(plan.UpgradeSchedule) {
+		return nil
+	}
+
+	upgrades, err := upgrade.GetScheduledUpgrades(ctx, r.ClusterCollection, state.ID.ValueString())
+	if err != nil {
+		return fmt.Errorf("failed to get upgrade policies: %v", err)
+	}
+	err = upgrade.ReconcileRecurringUpgrade(ctx, r.ClusterCollection, state.ID.ValueString(),
+		upgrades, plan.UpgradeSchedule.ValueString())
+	if err != nil {
+		return err
+	}
+
+	state.UpgradeSchedule = plan.UpgradeSchedule
 	return nil
 }
 
@@ -1566,7 +1884,18 @@ func (r *ClusterRosaClassicResource) validateUpgrade(ctx context.Context, state,
 }
 
 // Ensure user has acked upgrade gates and schedule the upgrade
-func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient, clusterID string, desiredVersion *semver.Version, userAckString string) error {
+func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient, clusterID string, desiredVersion *semver.Version,
+	userAckString string, nextRun *time.Time) error {
+	// Upgrades start 10 minutes from now unless a maintenance window is requested
+	upgradeTime := time.Now().UTC().Add(10 * time.Minute)
+	if nextRun != nil {
+		if nextRun.Before(time.Now().UTC().Add(minUpgradeNextRunDelay)) {
+			return fmt.Errorf("upgrade_next_run '%s' must be at least %v in the future",
+				nextRun.Format(time.RFC3339), minUpgradeNextRunDelay)
+		}
+		upgradeTime = *nextRun
+	}
+
 	// Gate agreements are checked when the upgrade is scheduled, resulting
 	// in an error return. ROSA cli does this by scheduling once w/ dryRun
 	// to look for un-acked agreements.
@@ -1584,6 +1913,21 @@ func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient, clusterID
 		}
 	}
 	targetMinorVersion := getOcmVersionMinor(desiredVersion.String())
+	agreed := map[string]bool{}
+	if userAckRequired && userAckString != targetMinorVersion {
+		// Gates that were acknowledged beforehand, for example with the
+		// 'rhcs_version_gate_agreement' resource, don't need the user ack
+		agreed, err = common.AgreedVersionGates(ctx, clusterClient.GateAgreements())
+		if err != nil {
+			return err
+		}
+		userAckRequired = false
+		for _, gate := range gates {
+			if !gate.STSOnly() && !agreed[gate.ID()] {
+				userAckRequired = true
+			}
+		}
+	}
 	if userAckRequired && userAckString != targetMinorVersion { // User has not acknowledged mandatory gates, stop here.
 		return fmt.Errorf("%s\nTo acknowledge these items, please add \"upgrade_acknowledgements_for = %s\""+
 			" and re-apply the changes", description, targetMinorVersion)
@@ -1592,9 +1936,12 @@ func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient, clusterID
 	// Ack all gates to OCM
 	for _, gate := range gates {
 		gateID := gate.ID()
+		if agreed[gateID] {
+			continue
+		}
 		tflog.Debug(ctx, "Acknowledging version gate", map[string]any{"gateID": gateID})
 		gateAgreementsClient := clusterClient.GateAgreements()
-		err := upgrade.AckVersionGate(gateAgreementsClient, gateID)
+		_, err := upgrade.AckVersionGate(gateAgreementsClient, gateID)
 		if err != nil {
 			return fmt.Errorf("failed to acknowledge version gate '%s' for cluster '%s': %v",
 				gateID, clusterID, err)
@@ -1602,11 +1949,10 @@ func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient, clusterID
 	}
 
 	// Schedule an upgrade
-	tenMinFromNow := time.Now().UTC().Add(10 * time.Minute)
 	newPolicy, err := cmv1.NewUpgradePolicy().
 		ScheduleType(cmv1.ScheduleTypeManual).
 		Version(desiredVersion.String()).
-		NextRun(tenMinFromNow).
+		NextRun(upgradeTime).
 		Build()
 	if err != nil {
 		return fmt.Errorf("failed to create upgrade policy: %v", err)
@@ -1621,6 +1967,23 @@ func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient, clusterID
 	return nil
 }
 
+// Converts the node drain grace period returned by OCM, that can be expressed in
+// hours, to whole minutes
+func nodeDrainGracePeriodInMinutes(value *cmv1.Value) (int64, error) {
+	minutes := value.Value()
+	switch strings.TrimSuffix(value.Unit(), "s") {
+	case "", "minute":
+	case "hour":
+		minutes = minutes * 60
+	default:
+		return 0, fmt.Errorf("unsupported node_drain_grace_period unit '%s'", value.Unit())
+	}
+	if minutes != math.Trunc(minutes) {
+		return 0, fmt.Errorf("OCM returned non-integer node_drain_grace_period value: %v", minutes)
+	}
+	return int64(minutes), nil
+}
+
 func updateProxy(state, plan *ClusterRosaClassicState, clusterBuilder *cmv1.ClusterBuilder) (*cmv1.ClusterBuilder, error) {
 	if !reflect.DeepEqual(state.Proxy, plan.Proxy) {
 		var err error
@@ -1686,6 +2049,12 @@ func (r *ClusterRosaClassicResource) Delete(ctx context.Context, request resourc
 				timeout = state.DestroyTimeout.ValueInt64()
 			}
 		}
+		deleteTimeout, diags := state.Timeouts.Delete(ctx, time.Duration(timeout)*time.Minute)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		timeout = common.DurationToMinutes(deleteTimeout)
 		isNotFound, err := r.retryClusterNotFoundWithTimeout(3, 1*time.Minute, ctx, timeout, clusterClient)
 		if err != nil {
 			response.Diagnostics.AddError(
@@ -1719,7 +2088,7 @@ func (r *ClusterRosaClassicResource) ImportState(ctx context.Context, request re
 }
 
 // populateRosaClassicClusterState copies the data from the API object to the Terraform state.
-func populateRosaClassicClusterState(ctx context.Context, object *cmv1.Cluster, state *ClusterRosaClassicState, httpClient common.HttpClient) error {
+func populateRosaClassicClusterState(ctx context.Context, object *cmv1.Cluster, state *ClusterRosaClassicState, httpClient common.HttpClient, defaultProperties map[string]string) error {
 	state.ID = types.StringValue(object.ID())
 	state.ExternalID = types.StringValue(object.ExternalID())
 	object.API()
@@ -1736,6 +2105,13 @@ func populateRosaClassicClusterState(ctx context.Context, object *cmv1.Cluster,
 				propertiesMap[k] = v
 			}
 		}
+		// The default properties of the provider only appear in 'properties' when the user set
+		// them explicitly, otherwise they would show up as drift:
+		explicitProperties, err := common.OptionalMap(ctx, state.Properties)
+		if err != nil {
+			return err
+		}
+		propertiesMap = common.FilterDefaults(propertiesMap, defaultProperties, explicitProperties)
 		mapValue, err := common.ConvertStringMapToMapType(propertiesMap)
 		if err != nil {
 			return err
@@ -1760,6 +2136,15 @@ func populateRosaClassicClusterState(ctx context.Context, object *cmv1.Cluster,
 		state.DisableWorkloadMonitoring = types.BoolValue(true)
 	}
 
+	state.NodeDrainGracePeriod = types.Int64Null()
+	if nodeDrainGracePeriod, ok := object.GetNodeDrainGracePeriod(); ok {
+		minutes, err := nodeDrainGracePeriodInMinutes(nodeDrainGracePeriod)
+		if err != nil {
+			return err
+		}
+		state.NodeDrainGracePeriod = types.Int64Value(minutes)
+	}
+
 	isFips, ok := object.GetFIPS()
 	if ok && isFips {
 		state.FIPS = types.BoolValue(true)
@@ -1830,7 +2215,7 @@ func populateRosaClassicClusterState(ctx context.Context, object *cmv1.Cluster,
 		if state.Sts == nil {
 			state.Sts = &sts.ClassicSts{}
 		}
-		oidcEndpointUrl := strings.TrimPrefix(stsState.OIDCEndpointURL(), "https://")
+		oidcEndpointUrl := common.OIDCEndpointURL(stsState.OIDCEndpointURL())
 
 		state.Sts.OIDCEndpointURL = types.StringValue(oidcEndpointUrl)
 		state.Sts.RoleARN = types.StringValue(stsState.RoleARN())
@@ -2044,15 +2429,24 @@ func (r *ClusterRosaClassicResource) waitTillClusterIsNotFoundWithTimeout(ctx co
 	return false, nil
 }
 
-func shouldPatchProperties(state, plan *ClusterRosaClassicState) bool {
+func shouldPatchProperties(state, plan *ClusterRosaClassicState, defaultProperties map[string]string) bool {
 	// User defined properties needs update
 	if _, should := common.ShouldPatchMap(state.Properties, plan.Properties); should {
 		return true
 	}
 
+	// Default properties of the provider need update
+	if rosa.DefaultPropertiesMissing(context.Background(), state.OCMProperties, defaultProperties) {
+		return true
+	}
+
 	extractedDefaults := map[string]string{}
 	for k, v := range state.OCMProperties.Elements() {
-		if _, ok := state.Properties.Elements()[k]; !ok {
+		if _, ok := state.Properties.Elements()[k]; ok {
+			continue
+		}
+		// The default properties of the provider were already checked above:
+		if _, ok := defaultProperties[k]; !ok {
 			extractedDefaults[k] = v.(types.String).ValueString()
 		}
 	}
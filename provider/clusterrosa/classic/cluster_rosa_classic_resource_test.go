@@ -169,7 +169,7 @@ var _ = Describe("Rosa Classic Sts cluster", func() {
 	Context("createClassicClusterObject", func() {
 		It("Creates a cluster with correct field values", func() {
 			clusterState := generateBasicRosaClassicClusterState()
-			rosaClusterObject, err := createClassicClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+			rosaClusterObject, err := createClassicClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(rosaClusterObject.Name()).To(Equal(clusterName))
@@ -203,21 +203,21 @@ var _ = Describe("Rosa Classic Sts cluster", func() {
 	It("Throws an error when version format is invalid", func() {
 		clusterState := generateBasicRosaClassicClusterState()
 		clusterState.Version = types.StringValue("a.4.1")
-		_, err := createClassicClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+		_, err := createClassicClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 		Expect(err).To(HaveOccurred())
 	})
 
 	It("Throws an error when version is unsupported", func() {
 		clusterState := generateBasicRosaClassicClusterState()
 		clusterState.Version = types.StringValue("4.1.0")
-		_, err := createClassicClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+		_, err := createClassicClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 		Expect(err).To(HaveOccurred())
 	})
 
 	It("appends the non-default channel name to the requested version", func() {
 		clusterState := generateBasicRosaClassicClusterState()
 		clusterState.ChannelGroup = types.StringValue("somechannel")
-		rosaClusterObject, err := createClassicClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+		rosaClusterObject, err := createClassicClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 		Expect(err).ToNot(HaveOccurred())
 
 		version, ok := rosaClusterObject.Version().GetID()
@@ -237,7 +237,7 @@ var _ = Describe("Rosa Classic Sts cluster", func() {
 
 			clusterObject, err := cmv1.UnmarshalCluster(clusterJsonString)
 			Expect(err).ToNot(HaveOccurred())
-			Expect(populateRosaClassicClusterState(context.Background(), clusterObject, clusterState, mockHttpClient)).To(Succeed())
+			Expect(populateRosaClassicClusterState(context.Background(), clusterObject, clusterState, mockHttpClient, nil)).To(Succeed())
 
 			Expect(clusterState.ID.ValueString()).To(Equal(clusterId))
 			Expect(clusterState.CloudRegion.ValueString()).To(Equal(regionId))
@@ -281,7 +281,7 @@ var _ = Describe("Rosa Classic Sts cluster", func() {
 			clusterObject, err := cmv1.UnmarshalCluster(clusterJsonString)
 			Expect(err).ToNot(HaveOccurred())
 
-			err = populateRosaClassicClusterState(context.Background(), clusterObject, clusterState, mockHttpClient)
+			err = populateRosaClassicClusterState(context.Background(), clusterObject, clusterState, mockHttpClient, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(clusterState.Channel.ValueString()).To(Equal("stable-4.15"))
@@ -302,7 +302,7 @@ var _ = Describe("Rosa Classic Sts cluster", func() {
 			clusterObject, err := cmv1.UnmarshalCluster(clusterJsonString)
 			Expect(err).ToNot(HaveOccurred())
 
-			err = populateRosaClassicClusterState(context.Background(), clusterObject, clusterState, mockHttpClient)
+			err = populateRosaClassicClusterState(context.Background(), clusterObject, clusterState, mockHttpClient, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(clusterState.Channel.IsNull()).To(BeTrue())
@@ -321,7 +321,7 @@ var _ = Describe("Rosa Classic Sts cluster", func() {
 			clusterObject, err := cmv1.UnmarshalCluster(clusterJsonString)
 			Expect(err).ToNot(HaveOccurred())
 
-			err = populateRosaClassicClusterState(context.Background(), clusterObject, clusterState, mockHttpClient)
+			err = populateRosaClassicClusterState(context.Background(), clusterObject, clusterState, mockHttpClient, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(clusterState.Sts.OIDCEndpointURL.ValueString()).To(Equal("nonce.com"))
 		})
@@ -336,7 +336,7 @@ var _ = Describe("Rosa Classic Sts cluster", func() {
 			clusterObject, err := cmv1.UnmarshalCluster(clusterJsonString)
 			Expect(err).ToNot(HaveOccurred())
 
-			err = populateRosaClassicClusterState(context.Background(), clusterObject, clusterState, mockHttpClient)
+			err = populateRosaClassicClusterState(context.Background(), clusterObject, clusterState, mockHttpClient, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(clusterState.Sts.Thumbprint.ValueString()).To(Equal(""))
 		})
@@ -360,7 +360,7 @@ var _ = Describe("Rosa Classic Sts cluster", func() {
 	Context("create cluster admin user", func() {
 		It("No cluster admin user created", func() {
 			clusterState := generateBasicRosaClassicClusterState()
-			rosaClusterObject, err := createClassicClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+			rosaClusterObject, err := createClassicClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 			Expect(err).To(BeNil())
 			idp := rosaClusterObject.Htpasswd()
 			Expect(idp).To(BeZero())
@@ -368,7 +368,7 @@ var _ = Describe("Rosa Classic Sts cluster", func() {
 		It("Cluster admin user is created with create_admin_user", func() {
 			clusterState := generateBasicRosaClassicClusterState()
 			clusterState.CreateAdminUser = types.BoolValue(true)
-			rosaClusterObject, err := createClassicClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+			rosaClusterObject, err := createClassicClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 			Expect(err).To(BeNil())
 			idp := rosaClusterObject.Htpasswd()
 			Expect(idp).NotTo(BeZero())
@@ -382,7 +382,7 @@ var _ = Describe("Rosa Classic Sts cluster", func() {
 			username := "test-username"
 			clusterState := generateBasicRosaClassicClusterState()
 			clusterState.AdminCredentials = rosaTypes.FlattenAdminCredentials(username, "")
-			rosaClusterObject, err := createClassicClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+			rosaClusterObject, err := createClassicClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 			Expect(err).To(BeNil())
 			idp := rosaClusterObject.Htpasswd()
 			Expect(idp).NotTo(BeZero())
@@ -436,7 +436,7 @@ var _ = Describe("Rosa Classic Sts cluster", func() {
 			clusterState.ChannelGroup = types.StringNull()
 			clusterState.Version = types.StringNull()
 
-			rosaClusterObject, err := createClassicClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+			rosaClusterObject, err := createClassicClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			channel, ok := rosaClusterObject.GetChannel()
@@ -450,7 +450,7 @@ var _ = Describe("Rosa Classic Sts cluster", func() {
 			clusterState.ChannelGroup = types.StringNull()
 			clusterState.Version = types.StringValue("4.14.5")
 
-			rosaClusterObject, err := createClassicClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+			rosaClusterObject, err := createClassicClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			channel, ok := rosaClusterObject.GetChannel()
@@ -468,7 +468,7 @@ var _ = Describe("Rosa Classic Sts cluster", func() {
 			clusterState.ChannelGroup = types.StringNull()
 			clusterState.Version = types.StringNull()
 
-			_, err := createClassicClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+			_, err := createClassicClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("Channel stable-4.9 is not supported"))
 			Expect(err.Error()).To(ContainSubstring("minimal supported version is 4.10.0"))
@@ -17,6 +17,7 @@ limitations under the License.
 package classic
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	rosaTypes "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common/types"
@@ -65,6 +66,7 @@ type ClusterRosaClassicState struct {
 	Properties                                types.Map                    `tfsdk:"properties"`
 	OCMProperties                             types.Map                    `tfsdk:"ocm_properties"`
 	Tags                                      types.Map                    `tfsdk:"tags"`
+	TagsAll                                   types.Map                    `tfsdk:"tags_all"`
 	ServiceCIDR                               types.String                 `tfsdk:"service_cidr"`
 	Proxy                                     *proxy.Proxy                 `tfsdk:"proxy"`
 	State                                     types.String                 `tfsdk:"state"`
@@ -73,15 +75,23 @@ type ClusterRosaClassicState struct {
 	Ec2MetadataHttpTokens                     types.String                 `tfsdk:"ec2_metadata_http_tokens"`
 	CreateAdminUser                           types.Bool                   `tfsdk:"create_admin_user"`
 	AdminCredentials                          types.Object                 `tfsdk:"admin_credentials"`
+	AdminPasswordWo                           types.String                 `tfsdk:"admin_password_wo"`
+	AdminPasswordWoVersion                    types.Int64                  `tfsdk:"admin_password_wo_version"`
 	PrivateHostedZone                         *rosaTypes.PrivateHostedZone `tfsdk:"private_hosted_zone"`
 	BaseDNSDomain                             types.String                 `tfsdk:"base_dns_domain"`
 
-	UpgradeAcksFor types.String `tfsdk:"upgrade_acknowledgements_for"`
+	UpgradeAcksFor       types.String `tfsdk:"upgrade_acknowledgements_for"`
+	UpgradeNextRun       types.String `tfsdk:"upgrade_next_run"`
+	UpgradeSchedule      types.String `tfsdk:"upgrade_schedule"`
+	NodeDrainGracePeriod types.Int64  `tfsdk:"node_drain_grace_period"`
 
 	DisableWaitingInDestroy        types.Bool  `tfsdk:"disable_waiting_in_destroy"`
 	DestroyTimeout                 types.Int64 `tfsdk:"destroy_timeout"`
 	WaitForCreateComplete          types.Bool  `tfsdk:"wait_for_create_complete"`
+	WaitForUpgradeComplete         types.Bool  `tfsdk:"wait_for_upgrade_complete"`
 	MaxClusterWaitTimeoutInMinutes types.Int64 `tfsdk:"max_cluster_wait_timeout_in_minutes"`
 
 	DeleteProtection types.Bool `tfsdk:"delete_protection"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
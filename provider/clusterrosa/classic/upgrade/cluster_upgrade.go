@@ -48,6 +48,20 @@ func (cu *ClusterUpgrade) NextRun() time.Time {
 	return cu.policy.NextRun()
 }
 
+func (cu *ClusterUpgrade) ID() string {
+	return cu.policy.ID()
+}
+
+func (cu *ClusterUpgrade) Schedule() string {
+	return cu.policy.Schedule()
+}
+
+// IsRecurring reports whether the upgrade is driven by a recurring (automatic)
+// policy rather than pinned to a version
+func (cu *ClusterUpgrade) IsRecurring() bool {
+	return cu.policy.ScheduleType() == cmv1.ScheduleTypeAutomatic
+}
+
 func (cu *ClusterUpgrade) Delete(ctx context.Context, client *cmv1.ClustersClient) error {
 	_, err := client.Cluster(cu.policy.ClusterID()).UpgradePolicies().UpgradePolicy(cu.policy.ID()).Delete().SendContext(ctx)
 	if err != nil {
@@ -137,6 +151,14 @@ func GetScheduledUpgrades(ctx context.Context, client *cmv1.ClustersClient, clus
 // for the correct version, and returning an error if there is already an
 // upgrade in progress that is not for the desired version
 func CheckAndCancelUpgrades(ctx context.Context, client *cmv1.ClustersClient, upgrades []ClusterUpgrade, desiredVersion *semver.Version) (bool, error) {
+	return CheckAndCancelUpgradesAt(ctx, client, upgrades, desiredVersion, nil)
+}
+
+// Same as CheckAndCancelUpgrades, but when a desired next run is given a
+// pending upgrade to the desired version is only kept if it is scheduled at
+// that time, so that it gets rescheduled when the maintenance window changes
+func CheckAndCancelUpgradesAt(ctx context.Context, client *cmv1.ClustersClient, upgrades []ClusterUpgrade,
+	desiredVersion *semver.Version, desiredNextRun *time.Time) (bool, error) {
 	correctUpgradePending := false
 	tenMinFromNow := time.Now().UTC().Add(10 * time.Minute)
 
@@ -146,7 +168,7 @@ func CheckAndCancelUpgrades(ctx context.Context, client *cmv1.ClustersClient, up
 		// selects the target version at runtime - so Version() is empty. Skip
 		// them rather than failing semver parsing (issue #1186); they are not a
 		// pending pinned upgrade that needs reconciling against desiredVersion.
-		if upgrade.Version() == "" {
+		if upgrade.IsRecurring() || upgrade.Version() == "" {
 			tflog.Debug(ctx, "Skipping recurring upgrade policy with no pinned version")
 			continue
 		}
@@ -161,7 +183,11 @@ func CheckAndCancelUpgrades(ctx context.Context, client *cmv1.ClustersClient, up
 			}
 			correctUpgradePending = true
 		case cmv1.UpgradePolicyStateValuePending, cmv1.UpgradePolicyStateValueScheduled:
-			if desiredVersion.Equal(toVersion) && upgrade.NextRun().Before(tenMinFromNow) {
+			scheduledAsDesired := upgrade.NextRun().Before(tenMinFromNow)
+			if desiredNextRun != nil {
+				scheduledAsDesired = upgrade.NextRun().Equal(*desiredNextRun)
+			}
+			if desiredVersion.Equal(toVersion) && scheduledAsDesired {
 				correctUpgradePending = true
 			} else {
 				// The upgrade is not one we want, so cancel it
@@ -174,20 +200,123 @@ func CheckAndCancelUpgrades(ctx context.Context, client *cmv1.ClustersClient, up
 	return correctUpgradePending, nil
 }
 
+// Get the progress of the upgrade of the cluster to the given version. The
+// upgrade policy is removed once the upgrade finishes, so when there is no
+// policy for the version the current version of the cluster is checked
+func GetUpgradeProgress(ctx context.Context, client *cmv1.ClustersClient, clusterId string,
+	desiredVersion *semver.Version) (*common.UpgradeProgress, error) {
+	upgrades, err := GetScheduledUpgrades(ctx, client, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	for _, upgrade := range upgrades {
+		if upgrade.IsRecurring() || upgrade.Version() == "" {
+			continue
+		}
+		toVersion, err := semver.NewVersion(upgrade.Version())
+		if err != nil || !desiredVersion.Equal(toVersion) {
+			continue
+		}
+		return &common.UpgradeProgress{
+			Completed:   upgrade.State() == cmv1.UpgradePolicyStateValueCompleted,
+			State:       upgrade.State(),
+			Description: upgrade.policyState.Description(),
+		}, nil
+	}
+	resp, err := client.Cluster(clusterId).Get().SendContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster: %v", err)
+	}
+	currentVersion, err := semver.NewVersion(resp.Body().Version().RawID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse current cluster version: %v", err)
+	}
+	return &common.UpgradeProgress{
+		Completed: !currentVersion.LessThan(desiredVersion),
+	}, nil
+}
+
+// Find the recurring upgrade policy in the given list of upgrades. OCM allows
+// at most one of them per cluster, so the first match is returned, or nil if
+// there is none
+func FindRecurringUpgrade(upgrades []ClusterUpgrade) *ClusterUpgrade {
+	for i := range upgrades {
+		if upgrades[i].IsRecurring() {
+			return &upgrades[i]
+		}
+	}
+	return nil
+}
+
+// Make the recurring upgrade policy of the cluster match the given cron
+// schedule: it is created when missing, rescheduled when the schedule differs
+// and cancelled when the schedule is empty
+func ReconcileRecurringUpgrade(ctx context.Context, client *cmv1.ClustersClient, clusterId string,
+	upgrades []ClusterUpgrade, schedule string) error {
+	upgradePoliciesClient := client.Cluster(clusterId).UpgradePolicies()
+	recurring := FindRecurringUpgrade(upgrades)
+
+	if schedule == "" {
+		if recurring == nil {
+			return nil
+		}
+		tflog.Debug(ctx, "Cancelling recurring upgrade policy", map[string]any{"policyID": recurring.ID()})
+		return recurring.Delete(ctx, client)
+	}
+
+	if recurring != nil {
+		if recurring.Schedule() == schedule {
+			return nil
+		}
+		patch, err := cmv1.NewUpgradePolicy().
+			ScheduleType(cmv1.ScheduleTypeAutomatic).
+			Schedule(schedule).
+			Build()
+		if err != nil {
+			return fmt.Errorf("failed to build upgrade policy: %v", err)
+		}
+		tflog.Debug(ctx, "Rescheduling recurring upgrade policy", map[string]any{
+			"policyID": recurring.ID(),
+			"schedule": schedule,
+		})
+		_, err = upgradePoliciesClient.UpgradePolicy(recurring.ID()).Update().Body(patch).SendContext(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update upgrade policy: %v", err)
+		}
+		return nil
+	}
+
+	policy, err := cmv1.NewUpgradePolicy().
+		UpgradeType(cmv1.UpgradeTypeOSD).
+		ScheduleType(cmv1.ScheduleTypeAutomatic).
+		Schedule(schedule).
+		Build()
+	if err != nil {
+		return fmt.Errorf("failed to build upgrade policy: %v", err)
+	}
+	tflog.Debug(ctx, "Creating recurring upgrade policy", map[string]any{"schedule": schedule})
+	_, err = upgradePoliciesClient.Add().Body(policy).SendContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create upgrade policy: %v", err)
+	}
+	return nil
+}
+
+// Acknowledge the given version gate, returning the created agreement
 func AckVersionGate(
 	gateAgreementsClient *cmv1.VersionGateAgreementsClient,
-	gateID string) error {
+	gateID string) (*cmv1.VersionGateAgreement, error) {
 	agreement, err := cmv1.NewVersionGateAgreement().
 		VersionGate(cmv1.NewVersionGate().ID(gateID)).
 		Build()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	response, err := gateAgreementsClient.Add().Body(agreement).Send()
 	if err != nil {
-		return common.HandleErr(response.Error(), err)
+		return nil, common.HandleErr(response.Error(), err)
 	}
-	return nil
+	return response.Body(), nil
 }
 
 // Construct a list of missing gate agreements for upgrade to a given cluster version
@@ -18,6 +18,7 @@ package upgrade
 import (
 	"context"
 	"testing"
+	"time"
 
 	semver "github.com/hashicorp/go-version"
 	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
@@ -97,3 +98,50 @@ var _ = Describe("CheckAndCancelUpgrades", func() {
 		Expect(err).To(HaveOccurred())
 	})
 })
+
+var _ = Describe("CheckAndCancelUpgradesAt", func() {
+	ctx := context.Background()
+
+	It("keeps a pending upgrade scheduled inside the requested window", func() {
+		desired := semver.Must(semver.NewVersion("4.21.20"))
+		nextRun := time.Now().UTC().Add(48 * time.Hour).Truncate(time.Second)
+		policy, err := cmv1.NewUpgradePolicy().
+			ScheduleType(cmv1.ScheduleTypeManual).
+			Version("4.21.20").
+			NextRun(nextRun).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		policyState, err := cmv1.NewUpgradePolicyState().Value(cmv1.UpgradePolicyStateValueScheduled).Build()
+		Expect(err).ToNot(HaveOccurred())
+		upgrades := []ClusterUpgrade{{policy: policy, policyState: policyState}}
+
+		// A pending upgrade outside of the default 10 minutes would be cancelled
+		// without a window, so a nil client proves it is kept here.
+		correctUpgradePending, err := CheckAndCancelUpgradesAt(ctx, nil, upgrades, desired, &nextRun)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(correctUpgradePending).To(BeTrue())
+	})
+})
+
+var _ = Describe("FindRecurringUpgrade", func() {
+	It("returns the recurring policy", func() {
+		pinned, err := cmv1.NewUpgradePolicy().ID("pinned").ScheduleType(cmv1.ScheduleTypeManual).Build()
+		Expect(err).ToNot(HaveOccurred())
+		recurring, err := cmv1.NewUpgradePolicy().ID("recurring").
+			ScheduleType(cmv1.ScheduleTypeAutomatic).
+			Schedule("0 22 * * 6").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		found := FindRecurringUpgrade([]ClusterUpgrade{{policy: pinned}, {policy: recurring}})
+
+		Expect(found).ToNot(BeNil())
+		Expect(found.ID()).To(Equal("recurring"))
+		Expect(found.Schedule()).To(Equal("0 22 * * 6"))
+	})
+
+	It("returns nil when there is no recurring policy", func() {
+		Expect(FindRecurringUpgrade([]ClusterUpgrade{})).To(BeNil())
+	})
+})
@@ -5,6 +5,7 @@ package classic
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -272,7 +273,7 @@ var _ = Describe("shouldPatchProperties", func() {
 			"rosa_creator_arn": types.StringValue("arn:aws:iam::123456789012:user/other"),
 		})
 
-		Expect(shouldPatchProperties(state, plan)).To(BeTrue())
+		Expect(shouldPatchProperties(state, plan, nil)).To(BeTrue())
 	})
 
 	It("returns false when properties and OCM defaults are unchanged", func() {
@@ -284,7 +285,7 @@ var _ = Describe("shouldPatchProperties", func() {
 		})
 		plan.OCMProperties = state.OCMProperties
 
-		Expect(shouldPatchProperties(state, plan)).To(BeFalse())
+		Expect(shouldPatchProperties(state, plan, nil)).To(BeFalse())
 	})
 
 	It("returns true when OCM default property values drift", func() {
@@ -296,6 +297,51 @@ var _ = Describe("shouldPatchProperties", func() {
 		})
 		plan.OCMProperties = state.OCMProperties
 
-		Expect(shouldPatchProperties(state, plan)).To(BeTrue())
+		Expect(shouldPatchProperties(state, plan, nil)).To(BeTrue())
+	})
+
+	It("checks the default properties of the provider", func() {
+		state := cloneBasicState()
+		plan := cloneBasicState()
+		state.OCMProperties = types.MapValueMust(types.StringType, map[string]attr.Value{
+			rosa.PropertyRosaTfVersion: types.StringValue(rosa.OCMProperties[rosa.PropertyRosaTfVersion]),
+			rosa.PropertyRosaTfCommit:  types.StringValue(rosa.OCMProperties[rosa.PropertyRosaTfCommit]),
+			"owner":                    types.StringValue("team"),
+		})
+		plan.OCMProperties = state.OCMProperties
+
+		Expect(shouldPatchProperties(state, plan, map[string]string{"owner": "team"})).To(BeFalse())
+		Expect(shouldPatchProperties(state, plan, map[string]string{"owner": "other-team"})).To(BeTrue())
+	})
+})
+
+var _ = Describe("upgradeNextRun", func() {
+	future := time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+	past := time.Now().UTC().Add(-time.Hour).Format(time.RFC3339)
+
+	run := func(stateValue, planValue types.String) *time.Time {
+		state := cloneBasicState()
+		plan := cloneBasicState()
+		state.UpgradeNextRun = stateValue
+		plan.UpgradeNextRun = planValue
+		nextRun, err := upgradeNextRun(state, plan)
+		Expect(err).NotTo(HaveOccurred())
+		return nextRun
+	}
+
+	It("returns nil when not set", func() {
+		Expect(run(types.StringNull(), types.StringNull())).To(BeNil())
+	})
+
+	It("returns a future time", func() {
+		Expect(run(types.StringValue(future), types.StringValue(future))).NotTo(BeNil())
+	})
+
+	It("returns a past time that was just set, so that it is rejected", func() {
+		Expect(run(types.StringNull(), types.StringValue(past))).NotTo(BeNil())
+	})
+
+	It("ignores a past time kept from a previous apply", func() {
+		Expect(run(types.StringValue(past), types.StringValue(past))).To(BeNil())
 	})
 })
@@ -0,0 +1,122 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/identityprovider"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/identityprovider/htpasswd"
+)
+
+const (
+	AdminPasswordWriteOnlyAttribute        = "admin_password_wo"
+	AdminPasswordWriteOnlyVersionAttribute = "admin_password_wo_version"
+)
+
+// AdminPasswordWriteOnlyResourceSchema returns the schema definition for the admin_password_wo
+// resource attribute.
+func AdminPasswordWriteOnlyResourceSchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Admin password that will be created with the cluster, the value is never stored in the Terraform state. " +
+			"It can be used instead of `admin_credentials.password` to provide the password from an ephemeral source. " +
+			"Requires Terraform 1.11 or later.",
+		Optional:  true,
+		Sensitive: true,
+		WriteOnly: true,
+		Validators: append([]validator.String{
+			stringvalidator.ConflictsWith(path.MatchRoot("admin_credentials").AtName("password")),
+		}, identityprovider.HTPasswdPasswordValidators...),
+	}
+}
+
+// AdminPasswordWriteOnlyVersionResourceSchema returns the schema definition for the
+// admin_password_wo_version resource attribute.
+func AdminPasswordWriteOnlyVersionResourceSchema() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: "Version of `admin_password_wo`. As the password isn't stored in the Terraform state, " +
+			"change this value to rotate the admin password to the current value of `admin_password_wo`.",
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRoot(AdminPasswordWriteOnlyAttribute)),
+		},
+	}
+}
+
+// AdminPasswordWriteOnlyFromConfig reads the admin_password_wo attribute, write-only values are
+// only available in the configuration and never in the plan.
+func AdminPasswordWriteOnlyFromConfig(ctx context.Context, config tfsdk.Config) (types.String, diag.Diagnostics) {
+	password := types.StringNull()
+	diags := config.GetAttribute(ctx, path.Root(AdminPasswordWriteOnlyAttribute), &password)
+	return password, diags
+}
+
+// PlanAdminPasswordWriteOnly plans the password of 'admin_credentials' as null when the write-only
+// admin password is set, so that an existing cluster moving from 'admin_credentials.password' to
+// 'admin_password_wo' doesn't keep the previous password in the state.
+func PlanAdminPasswordWriteOnly(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Creation already leaves the password out of the state, and there is nothing to do on destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	password, diags := AdminPasswordWriteOnlyFromConfig(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || password.IsNull() {
+		return
+	}
+	var adminCredentials types.Object
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("admin_credentials"), &adminCredentials)...)
+	if resp.Diagnostics.HasError() || adminCredentials.IsNull() || adminCredentials.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("admin_credentials").AtName("password"),
+		types.StringNull())...)
+}
+
+// ShouldRotateAdminPassword reports whether admin_password_wo_version changed, which is the only
+// way to detect that a new write-only admin password has to be applied.
+func ShouldRotateAdminPassword(state, plan types.Int64) bool {
+	return !plan.IsUnknown() && !plan.IsNull() && !state.Equal(plan)
+}
+
+// RotateAdminPassword updates the password of the cluster admin user in the htpasswd identity
+// provider that holds it.
+func RotateAdminPassword(ctx context.Context, clusterClient *cmv1.ClusterClient, username, password string) error {
+	idpsResp, err := clusterClient.IdentityProviders().List().SendContext(ctx)
+	if err != nil {
+		return fmt.Errorf("can't list identity providers: %w", err)
+	}
+	for _, idp := range idpsResp.Items().Slice() {
+		if idp.Type() != cmv1.IdentityProviderTypeHtpasswd {
+			continue
+		}
+		idpClient := clusterClient.IdentityProviders().IdentityProvider(idp.ID())
+		usersResp, err := idpClient.HtpasswdUsers().List().SendContext(ctx)
+		if err != nil {
+			return fmt.Errorf("can't list users of identity provider '%s': %w", idp.Name(), err)
+		}
+		for _, user := range usersResp.Items().Slice() {
+			if user.Username() != username {
+				continue
+			}
+			if err := htpasswd.UpdateUser(ctx, password, user.ID(), idpClient); err != nil {
+				return fmt.Errorf("can't update password of admin user '%s': %w", username, err)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("admin user '%s' not found in the htpasswd identity providers of the cluster", username)
+}
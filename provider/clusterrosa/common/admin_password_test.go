@@ -0,0 +1,127 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	sdktesting "github.com/openshift-online/ocm-sdk-go/testing"
+)
+
+var _ = Describe("Admin password helpers", func() {
+	Context("ShouldRotateAdminPassword", func() {
+		It("returns false when the version is unchanged", func() {
+			Expect(ShouldRotateAdminPassword(types.Int64Value(1), types.Int64Value(1))).To(BeFalse())
+		})
+
+		It("returns false when the version is removed", func() {
+			Expect(ShouldRotateAdminPassword(types.Int64Value(1), types.Int64Null())).To(BeFalse())
+		})
+
+		It("returns true when the version is set for the first time", func() {
+			Expect(ShouldRotateAdminPassword(types.Int64Null(), types.Int64Value(1))).To(BeTrue())
+		})
+
+		It("returns true when the version changes", func() {
+			Expect(ShouldRotateAdminPassword(types.Int64Value(1), types.Int64Value(2))).To(BeTrue())
+		})
+	})
+
+	Context("RotateAdminPassword", func() {
+		var (
+			server        *ghttp.Server
+			ca            string
+			connection    *sdk.Connection
+			clusterClient *cmv1.ClusterClient
+			ctx           context.Context
+		)
+
+		BeforeEach(func() {
+			server, ca = sdktesting.MakeTCPTLSServer()
+			token := sdktesting.MakeTokenString("Bearer", 10*time.Minute)
+			ctx = context.Background()
+			var err error
+			connection, err = sdk.NewConnectionBuilder().
+				URL(server.URL()).
+				TrustedCAFile(ca).
+				Tokens(token).
+				BuildContext(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			clusterClient = connection.ClustersMgmt().V1().Clusters().Cluster("123")
+		})
+
+		AfterEach(func() {
+			server.Close()
+			connection.Close()
+		})
+
+		It("updates the password of the admin user", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/clusters_mgmt/v1/clusters/123/identity_providers"),
+					sdktesting.RespondWithJSON(http.StatusOK, `{
+						"kind": "IdentityProviderList",
+						"page": 1,
+						"size": 2,
+						"total": 2,
+						"items": [
+							{"kind": "IdentityProvider", "id": "idp-1", "name": "github", "type": "GithubIdentityProvider"},
+							{"kind": "IdentityProvider", "id": "idp-2", "name": "cluster-admin", "type": "HTPasswdIdentityProvider"}
+						]
+					}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/clusters_mgmt/v1/clusters/123/identity_providers/idp-2/htpasswd_users"),
+					sdktesting.RespondWithJSON(http.StatusOK, `{
+						"kind": "HTPasswdUserList",
+						"page": 1,
+						"size": 1,
+						"total": 1,
+						"items": [
+							{"kind": "HTPasswdUser", "id": "user-1", "username": "cluster-admin"}
+						]
+					}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PATCH", "/api/clusters_mgmt/v1/clusters/123/identity_providers/idp-2/htpasswd_users/user-1"),
+					sdktesting.VerifyJQ(".password", "New-Passw0rd-123"),
+					sdktesting.RespondWithJSON(http.StatusOK, `{"kind": "HTPasswdUser", "id": "user-1", "username": "cluster-admin"}`),
+				),
+			)
+			err := RotateAdminPassword(ctx, clusterClient, "cluster-admin", "New-Passw0rd-123")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns an error when the admin user doesn't exist", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/clusters_mgmt/v1/clusters/123/identity_providers"),
+					sdktesting.RespondWithJSON(http.StatusOK, `{
+						"kind": "IdentityProviderList",
+						"page": 1,
+						"size": 1,
+						"total": 1,
+						"items": [
+							{"kind": "IdentityProvider", "id": "idp-2", "name": "cluster-admin", "type": "HTPasswdIdentityProvider"}
+						]
+					}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/clusters_mgmt/v1/clusters/123/identity_providers/idp-2/htpasswd_users"),
+					sdktesting.RespondWithJSON(http.StatusOK, `{"kind": "HTPasswdUserList", "page": 1, "size": 0, "total": 0, "items": []}`),
+				),
+			)
+			err := RotateAdminPassword(ctx, clusterClient, "cluster-admin", "New-Passw0rd-123")
+			Expect(err).To(MatchError(ContainSubstring("admin user 'cluster-admin' not found")))
+		})
+	})
+})
@@ -35,6 +35,10 @@ const (
 	MaxClusterDomainPrefixLength = 15
 )
 
+// DomainPrefixRE matches a lowercase DNS label that starts with a letter, as required by OCM for
+// the cluster domain prefix.
+var DomainPrefixRE = regexp.MustCompile(`^[a-z]([-a-z0-9]*[a-z0-9])?$`)
+
 var UserArnRE = regexp.MustCompile("^(arn:(?:aws|aws-us-gov|aws-cn):(?:iam|sts)::\\d{12}(?:|:(?:root|user|assumed-role|role)(?:\\/?.+\\/?)?)(?:\\/[0-9A-Za-z\\+\\.@_,-]{1,64}))$")
 
 var OCMProperties = map[string]string{
@@ -30,3 +30,16 @@ func ValidatePatchProperties(ctx context.Context, state, plan types.Map) (map[st
 	}
 	return propertiesElements, nil
 }
+
+// DefaultPropertiesMissing checks if some of the given default properties of the provider are
+// missing from the given properties of a cluster, or have a different value.
+func DefaultPropertiesMissing(ctx context.Context, ocmProperties types.Map, defaults map[string]string) bool {
+	if !common.HasValue(ocmProperties) {
+		return false
+	}
+	current, err := common.OptionalMap(ctx, ocmProperties)
+	if err != nil {
+		return false
+	}
+	return common.DefaultsMissingFrom(defaults, current)
+}
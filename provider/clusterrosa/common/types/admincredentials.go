@@ -33,6 +33,10 @@ func FlattenAdminCredentials(username string, password string) types.Object {
 		"username": types.StringValue(username),
 		"password": types.StringValue(password),
 	}
+	// The password is not known when it was provided as a write-only value
+	if password == "" {
+		attrs["password"] = types.StringNull()
+	}
 
 	return types.ObjectValueMust(attributeTypes, attrs)
 }
@@ -51,6 +55,16 @@ func ExpandAdminCredentials(ctx context.Context, object types.Object, diags diag
 	return conf.Username.ValueString(), conf.Password.ValueString()
 }
 
+// AdminCredentialsWithoutPassword returns the given admin credentials with a null password, as
+// they are kept in the state when the password is given as a write-only value.
+func AdminCredentialsWithoutPassword(ctx context.Context, object types.Object) types.Object {
+	if !common.HasValue(object) {
+		return object
+	}
+	username, _ := ExpandAdminCredentials(ctx, object, diag.Diagnostics{})
+	return FlattenAdminCredentials(username, "")
+}
+
 func AdminCredentialsNull() types.Object {
 	return FlattenAdminCredentials("", "")
 }
@@ -9,6 +9,7 @@ import (
 	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/Masterminds/semver"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -44,6 +45,9 @@ type BaseCluster struct {
 	ClusterCollection *cmv1.ClustersClient
 	VersionCollection *cmv1.VersionsClient
 	ClusterWait       common.ClusterWait
+	PollingInterval   time.Duration
+	DefaultTags       map[string]string
+	DefaultProperties map[string]string
 }
 
 // getAndValidateVersionInChannelGroup ensures that the cluster version is
@@ -18,6 +18,11 @@ import (
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common/attrvalidators"
 )
 
+// IsValidDomainPrefix checks that the given value can be used as the domain prefix of a cluster.
+func IsValidDomainPrefix(domainPrefix string) bool {
+	return len(domainPrefix) <= MaxClusterDomainPrefixLength && DomainPrefixRE.MatchString(domainPrefix)
+}
+
 var AvailabilityZoneValidator = attrvalidators.NewStringValidator("AZ should be valid for cloud_region", func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
 	az := req.ConfigValue.ValueString()
 	regionAttr := basetypes.StringValue{}
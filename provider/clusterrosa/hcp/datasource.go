@@ -27,7 +27,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
 	rosa "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common"
@@ -43,6 +42,7 @@ type ClusterRosaHcpDatasource struct {
 	clusterCollection *cmv1.ClustersClient
 	versionCollection *cmv1.VersionsClient
 	clusterWait       common.ClusterWait
+	defaultProperties map[string]string
 }
 
 var _ datasource.DataSource = &ClusterRosaHcpDatasource{}
@@ -105,6 +105,11 @@ func (r *ClusterRosaHcpDatasource) Schema(ctx context.Context, req datasource.Sc
 				ElementType: types.StringType,
 				Computed:    true,
 			},
+			"tags_all": schema.MapAttribute{
+				Description: "All the tags applied to the cluster resources created in AWS, including the default tags of the provider.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			"etcd_encryption": schema.BoolAttribute{
 				Description: "Encrypt etcd data. Note that all AWS storage is already encrypted. " + common.ValueCannotBeChangedStringDescription,
 				Computed:    true,
@@ -316,6 +321,10 @@ func (r *ClusterRosaHcpDatasource) Schema(ctx context.Context, req datasource.Sc
 				Description: deprecatedMessage,
 				Computed:    true,
 			},
+			"wait_for_upgrade_complete": schema.BoolAttribute{
+				Description: deprecatedMessage,
+				Computed:    true,
+			},
 			"max_hcp_cluster_wait_timeout_in_minutes": schema.Int64Attribute{
 				Description: deprecatedMessage,
 				Computed:    true,
@@ -324,10 +333,19 @@ func (r *ClusterRosaHcpDatasource) Schema(ctx context.Context, req datasource.Sc
 				Description: deprecatedMessage,
 				Computed:    true,
 			},
+			"timeouts": common.DataSourceTimeoutsAttribute(timeoutsOpts, deprecatedMessage),
 			"create_admin_user": schema.BoolAttribute{
 				Description: deprecatedMessage,
 				Computed:    true,
 			},
+			"admin_password_wo": schema.StringAttribute{
+				Description: deprecatedMessage,
+				Computed:    true,
+			},
+			"admin_password_wo_version": schema.Int64Attribute{
+				Description: deprecatedMessage,
+				Computed:    true,
+			},
 			"admin_credentials": schema.SingleNestedAttribute{
 				Description: deprecatedMessage,
 				Attributes: map[string]schema.Attribute{
@@ -391,18 +409,21 @@ func (r *ClusterRosaHcpDatasource) Configure(ctx context.Context, req datasource
 		return
 	}
 
-	connection, ok := req.ProviderData.(*sdk.Connection)
+	providerData, ok := req.ProviderData.(*common.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.Connaction, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
+	connection := providerData.Connection
 
 	r.clusterCollection = connection.ClustersMgmt().V1().Clusters()
 	r.versionCollection = connection.ClustersMgmt().V1().Versions()
-	r.clusterWait = common.NewClusterWait(r.clusterCollection, connection)
+	r.clusterWait = common.NewClusterWait(r.clusterCollection, connection,
+		common.WithPollingInterval(providerData.ClusterWaitPollingInterval))
+	r.defaultProperties = providerData.DefaultProperties
 }
 
 func (r *ClusterRosaHcpDatasource) Read(ctx context.Context, request datasource.ReadRequest,
@@ -438,7 +459,7 @@ func (r *ClusterRosaHcpDatasource) Read(ctx context.Context, request datasource.
 	object := get.Body()
 
 	// Save the state:
-	err = populateRosaHcpClusterState(ctx, object, state)
+	err = populateRosaHcpClusterState(ctx, object, state, r.defaultProperties)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Can't populate cluster state",
@@ -467,9 +488,11 @@ func (r *ClusterRosaHcpDatasource) Read(ctx context.Context, request datasource.
 	state.ChannelGroup = types.StringNull()
 	state.Version = types.StringNull()
 	state.DestroyTimeout = types.Int64Null()
+	state.Timeouts = common.TimeoutsNull(timeoutsOpts)
 	state.UpgradeAcksFor = types.StringNull()
 	state.WaitForCreateComplete = types.BoolNull()
 	state.WaitForStdComputeNodesComplete = types.BoolNull()
+	state.WaitForUpgradeComplete = types.BoolNull()
 	state.AutoScalingEnabled = types.BoolNull()
 	state.MinReplicas = types.Int64Null()
 	state.MaxReplicas = types.Int64Null()
@@ -477,6 +500,8 @@ func (r *ClusterRosaHcpDatasource) Read(ctx context.Context, request datasource.
 	state.ComputeMachineType = types.StringNull()
 	state.CreateAdminUser = types.BoolNull()
 	state.AdminCredentials = rosaTypes.AdminCredentialsNull()
+	state.AdminPasswordWo = types.StringNull()
+	state.AdminPasswordWoVersion = types.Int64Null()
 	state.LogForwardersAtClusterCreation = types.ListNull(types.ObjectType{
 		AttrTypes: map[string]attr.Type{
 			"s3": types.ObjectType{
@@ -29,6 +29,7 @@ import (
 
 	awsarn "github.com/aws/aws-sdk-go-v2/aws/arn"
 	semver "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
@@ -46,6 +47,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	idputils "github.com/openshift-online/ocm-common/pkg/idp/utils"
@@ -53,7 +55,6 @@ import (
 	ocmUtils "github.com/openshift-online/ocm-common/pkg/ocm/utils"
 	"github.com/openshift-online/ocm-common/pkg/rosa/oidcconfigs"
 	commonutils "github.com/openshift-online/ocm-common/pkg/utils"
-	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	ocm_errors "github.com/openshift-online/ocm-sdk-go/errors"
 
@@ -65,6 +66,7 @@ import (
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/sts"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common/attrvalidators"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common/planmodifiers"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/identityprovider"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/proxy"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/registry_config"
@@ -82,6 +84,9 @@ type ClusterRosaHcpResource struct {
 
 var _ resource.ResourceWithConfigure = &ClusterRosaHcpResource{}
 var _ resource.ResourceWithImportState = &ClusterRosaHcpResource{}
+var _ resource.ResourceWithModifyPlan = &ClusterRosaHcpResource{}
+
+var timeoutsOpts = timeouts.Opts{Create: true, Update: true, Delete: true}
 
 func New() resource.Resource {
 	return &ClusterRosaHcpResource{}
@@ -132,6 +137,8 @@ func (r *ClusterRosaHcpResource) Schema(ctx context.Context, req resource.Schema
 				},
 				Validators: []validator.String{
 					stringvalidator.LengthAtMost(rosa.MaxClusterDomainPrefixLength),
+					stringvalidator.RegexMatches(rosa.DomainPrefixRE, "domain prefix must consist of lower case "+
+						"alphanumeric characters or '-', start with a letter and end with an alphanumeric character"),
 				},
 			},
 			"cloud_region": schema.StringAttribute{
@@ -151,7 +158,7 @@ func (r *ClusterRosaHcpResource) Schema(ctx context.Context, req resource.Schema
 				Validators:  []validator.Map{rosa.PropertiesValidator},
 			},
 			"ocm_properties": schema.MapAttribute{
-				Description: "Merged properties defined by OCM and the user defined 'properties'.",
+				Description: "Merged properties defined by OCM, the default properties of the provider and the user defined 'properties'.",
 				ElementType: types.StringType,
 				Computed:    true,
 			},
@@ -160,6 +167,11 @@ func (r *ClusterRosaHcpResource) Schema(ctx context.Context, req resource.Schema
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"tags_all": schema.MapAttribute{
+				Description: "All the tags applied to the cluster resources created in AWS, including the default tags of the provider.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			"etcd_encryption": schema.BoolAttribute{
 				Description: "Encrypt etcd data. Note that all AWS storage is already encrypted. " + common.ValueCannotBeChangedStringDescription,
 				Optional:    true,
@@ -378,7 +390,8 @@ func (r *ClusterRosaHcpResource) Schema(ctx context.Context, req resource.Schema
 					"and the resource remains in Terraform state so dependent STS resources (IAM roles, OIDC provider) " +
 					"are not removed while OCM uninstall may still be in progress. " +
 					"Set `disable_waiting_in_destroy = true` to skip this wait entirely.",
-				Optional: true,
+				DeprecationMessage: common.TimeoutDeprecationMessage("delete"),
+				Optional:           true,
 			},
 			"state": schema.StringAttribute{
 				Description: "State of the cluster.",
@@ -398,13 +411,21 @@ func (r *ClusterRosaHcpResource) Schema(ctx context.Context, req resource.Schema
 				Description: "Wait until the cluster standard compute pools are created. The waiter has a timeout of 60 minutes, with the default value set to false. This can only be provided when also waiting for create completion.",
 				Optional:    true,
 			},
+			"wait_for_upgrade_complete": schema.BoolAttribute{
+				Description: "Wait until the upgrade scheduled by a change of `version` is completed or has failed. The waiter " +
+					"uses the `update` timeout of the `timeouts` block, which defaults to 4 hours, with the default value " +
+					"set to false.",
+				Optional: true,
+			},
 			"max_hcp_cluster_wait_timeout_in_minutes": schema.Int64Attribute{
-				Description: "This value sets the maximum duration in minutes to wait for a HCP cluster to be in a ready state.",
-				Optional:    true,
+				Description:        "This value sets the maximum duration in minutes to wait for a HCP cluster to be in a ready state.",
+				DeprecationMessage: common.TimeoutDeprecationMessage("create"),
+				Optional:           true,
 			},
 			"max_machinepool_wait_timeout_in_minutes": schema.Int64Attribute{
-				Description: "This value sets the maximum duration in minutes to wait for machine pools to be in a ready state.",
-				Optional:    true,
+				Description:        "This value sets the maximum duration in minutes to wait for machine pools to be in a ready state.",
+				DeprecationMessage: common.TimeoutDeprecationMessage("create"),
+				Optional:           true,
 			},
 			"create_admin_user": schema.BoolAttribute{
 				Description: "Indicates if create cluster admin user. Set it true to create cluster admin user with default username `cluster-admin` " +
@@ -424,7 +445,7 @@ func (r *ClusterRosaHcpResource) Schema(ctx context.Context, req resource.Schema
 						},
 					},
 					"password": schema.StringAttribute{
-						Description: "Admin password that will be created with the cluster.",
+						Description: "Admin password that will be created with the cluster. It is removed from the state when `admin_password_wo` is set.",
 						Optional:    true,
 						Computed:    true,
 						Sensitive:   true,
@@ -440,6 +461,8 @@ func (r *ClusterRosaHcpResource) Schema(ctx context.Context, req resource.Schema
 					objectplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"admin_password_wo":         rosa.AdminPasswordWriteOnlyResourceSchema(),
+			"admin_password_wo_version": rosa.AdminPasswordWriteOnlyVersionResourceSchema(),
 			"ec2_metadata_http_tokens": schema.StringAttribute{
 				Description: "This value determines which EC2 Instance Metadata Service mode to use for EC2 instances in the cluster." +
 					"This can be set as `optional` (IMDS v1 or v2) or `required` (IMDSv2 only)." + common.ValueCannotBeChangedStringDescription,
@@ -577,6 +600,9 @@ func (r *ClusterRosaHcpResource) Schema(ctx context.Context, req resource.Schema
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeoutsOpts),
+		},
 	}
 }
 
@@ -595,18 +621,37 @@ func (r *ClusterRosaHcpResource) Configure(ctx context.Context, req resource.Con
 		return
 	}
 
-	connection, ok := req.ProviderData.(*sdk.Connection)
+	providerData, ok := req.ProviderData.(*common.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.Connection, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
+	connection := providerData.Connection
 
 	r.ClusterCollection = connection.ClustersMgmt().V1().Clusters()
 	r.VersionCollection = connection.ClustersMgmt().V1().Versions()
-	r.ClusterWait = common.NewClusterWait(r.ClusterCollection, connection)
+	r.ClusterWait = common.NewClusterWait(r.ClusterCollection, connection,
+		common.WithPollingInterval(providerData.ClusterWaitPollingInterval))
+	r.PollingInterval = providerData.ClusterWaitPollingInterval
+	r.DefaultTags = providerData.DefaultTags
+	r.DefaultProperties = providerData.DefaultProperties
+}
+
+func (r *ClusterRosaHcpResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	rosa.PlanAdminPasswordWriteOnly(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The defaults come from the configuration of the provider, so wait till it is configured:
+	if r.ClusterCollection == nil {
+		return
+	}
+	planmodifiers.MergeDefaultTags(ctx, req, resp, path.Root("tags"), path.Root("tags_all"), r.DefaultTags)
+	planmodifiers.ApplyDefaultProperties(ctx, req, resp, path.Root("ocm_properties"), r.DefaultProperties)
 }
 
 const (
@@ -614,7 +659,7 @@ const (
 )
 
 func createHcpClusterObject(ctx context.Context,
-	state *ClusterRosaHcpState, diags diag.Diagnostics) (*cmv1.Cluster, error) {
+	state *ClusterRosaHcpState, diags diag.Diagnostics, defaultProperties map[string]string) (*cmv1.Cluster, error) {
 
 	ocmClusterResource := ocmr.NewCluster()
 	builder := ocmClusterResource.GetClusterBuilder()
@@ -646,6 +691,7 @@ func createHcpClusterObject(ctx context.Context,
 	// Set default properties
 	properties := make(map[string]string)
 	maps.Copy(properties, rosa.OCMProperties)
+	maps.Copy(properties, defaultProperties)
 
 	// TODO: refactor to common pkg in properties file
 	if common.HasValue(state.Properties) {
@@ -750,7 +796,7 @@ func createHcpClusterObject(ctx context.Context,
 			common.OptionalString(state.Sts.TrustPolicyExternalID))
 	}
 
-	awsTags, err := common.OptionalMap(ctx, state.Tags)
+	awsTags, err := common.OptionalMap(ctx, state.TagsAll)
 	if err != nil {
 		return nil, err
 	}
@@ -895,11 +941,15 @@ func createHcpClusterObject(ctx context.Context,
 	}
 
 	username, password := rosaTypes.ExpandAdminCredentials(ctx, state.AdminCredentials, diags)
-	if common.BoolWithFalseDefault(state.CreateAdminUser) || common.HasValue(state.AdminCredentials) {
+	passwordWriteOnly := state.AdminPasswordWo.ValueString()
+	if common.BoolWithFalseDefault(state.CreateAdminUser) || common.HasValue(state.AdminCredentials) ||
+		passwordWriteOnly != "" {
 		if username == "" {
 			username = commonutils.ClusterAdminUsername
 		}
-		if password == "" {
+		if passwordWriteOnly != "" {
+			password = passwordWriteOnly
+		} else if password == "" {
 			password, err = idputils.GenerateRandomPassword()
 			if err != nil {
 				tflog.Error(ctx, "Failed to generate random password")
@@ -922,7 +972,12 @@ func createHcpClusterObject(ctx context.Context,
 		htPasswdIDP := cmv1.NewHTPasswdIdentityProvider().Users(htpassUserList)
 		builder.Htpasswd(htPasswdIDP)
 	}
-	state.AdminCredentials = rosaTypes.FlattenAdminCredentials(username, password)
+	if passwordWriteOnly != "" {
+		// Write-only passwords must not be stored in the state
+		state.AdminCredentials = rosaTypes.FlattenAdminCredentials(username, "")
+	} else {
+		state.AdminCredentials = rosaTypes.FlattenAdminCredentials(username, password)
+	}
 
 	builder, err = proxy.BuildProxy(state.Proxy, builder)
 	if err != nil {
@@ -971,6 +1026,11 @@ func (r *ClusterRosaHcpResource) Create(ctx context.Context, request resource.Cr
 	if response.Diagnostics.HasError() {
 		return
 	}
+	state.AdminPasswordWo, diags = rosa.AdminPasswordWriteOnlyFromConfig(ctx, request.Config)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 	enableDeleteProtection := common.HasValue(state.DeleteProtection) && state.DeleteProtection.ValueBool()
 	summary := "Can't build cluster"
 
@@ -1064,7 +1124,8 @@ func (r *ClusterRosaHcpResource) Create(ctx context.Context, request resource.Cr
 		}
 	}
 
-	object, err := createHcpClusterObject(ctx, state, diags)
+	object, err := createHcpClusterObject(ctx, state, diags, r.DefaultProperties)
+	state.AdminPasswordWo = types.StringNull()
 	if err != nil {
 		response.Diagnostics.AddError(
 			summary,
@@ -1100,7 +1161,7 @@ func (r *ClusterRosaHcpResource) Create(ctx context.Context, request resource.Cr
 	}
 
 	// Save initial state:
-	err = populateRosaHcpClusterState(ctx, object, state)
+	err = populateRosaHcpClusterState(ctx, object, state, r.DefaultProperties)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Can't populate cluster state",
@@ -1120,14 +1181,32 @@ func (r *ClusterRosaHcpResource) Create(ctx context.Context, request resource.Cr
 				"Waiting for cluster creation finished with error",
 				fmt.Sprintf("Waiting for cluster creation finished with the error %v", err),
 			)
+			diags = response.State.Set(ctx, state)
+			response.Diagnostics.Append(diags...)
+			return
+		}
+		// When the 'create' timeout is set it covers both the cluster and the standard compute
+		// nodes, otherwise each wait keeps using its own legacy timeout:
+		createTimeout, diags := state.Timeouts.Create(ctx, 0)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			diags = response.State.Set(ctx, state)
+			response.Diagnostics.Append(diags...)
+			return
+		}
+		createDeadline := time.Now().Add(createTimeout)
+		clusterTimeout := time.Duration(*timeOut) * time.Minute
+		if createTimeout > 0 {
+			clusterTimeout = createTimeout
 		}
-		object, err = r.ClusterWait.WaitForClusterToBeReady(ctx, object.ID(), *timeOut)
+		object, err = r.ClusterWait.WaitForClusterToBeReady(ctx, object.ID(), common.DurationToMinutes(clusterTimeout))
 		clusterReady = err == nil
 		if err != nil {
 			response.Diagnostics.AddError(
 				"Waiting for cluster creation finished with error",
 				fmt.Sprintf("Waiting for cluster creation finished with the error %v", err),
 			)
+			response.Diagnostics.Append(common.ClusterWaitDiagnostics(err)...)
 			if object == nil {
 				diags = response.State.Set(ctx, state)
 				response.Diagnostics.Append(diags...)
@@ -1143,8 +1222,15 @@ func (r *ClusterRosaHcpResource) Create(ctx context.Context, request resource.Cr
 					"Waiting for cluster creation finished with error",
 					fmt.Sprintf("Waiting for cluster creation finished with the error %v", err),
 				)
+				diags = response.State.Set(ctx, state)
+				response.Diagnostics.Append(diags...)
+				return
 			}
-			object, err = r.ClusterWait.WaitForStdComputeNodesToBeReady(ctx, object.ID(), *timeOut)
+			computeTimeout := time.Duration(*timeOut) * time.Minute
+			if createTimeout > 0 {
+				computeTimeout = time.Until(createDeadline)
+			}
+			object, err = r.ClusterWait.WaitForStdComputeNodesToBeReady(ctx, object.ID(), common.DurationToMinutes(computeTimeout))
 			if err != nil {
 				response.Diagnostics.AddError(
 					"Waiting for std compute nodes completion finished with error",
@@ -1161,7 +1247,7 @@ func (r *ClusterRosaHcpResource) Create(ctx context.Context, request resource.Cr
 	}
 
 	// Save the state post wait completion:
-	err = populateRosaHcpClusterState(ctx, object, state)
+	err = populateRosaHcpClusterState(ctx, object, state, r.DefaultProperties)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Can't populate cluster state",
@@ -1296,7 +1382,7 @@ func (r *ClusterRosaHcpResource) Read(ctx context.Context, request resource.Read
 	object := get.Body()
 
 	// Save the state:
-	err = populateRosaHcpClusterState(ctx, object, state)
+	err = populateRosaHcpClusterState(ctx, object, state, r.DefaultProperties)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Can't populate cluster state",
@@ -1337,6 +1423,9 @@ func (r *ClusterRosaHcpResource) Read(ctx context.Context, request resource.Read
 		state.DeleteProtection = dpVal
 	}
 
+	// Warn about limited support, so that it is noticed when planning:
+	response.Diagnostics.Append(common.LimitedSupportWarnings(ctx, r.ClusterCollection, object)...)
+
 	diags = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diags...)
 }
@@ -1512,6 +1601,17 @@ func (r *ClusterRosaHcpResource) Update(ctx context.Context, request resource.Up
 		return
 	}
 
+	// The admin password isn't kept in the state once the write-only one is used, as planned by
+	// ModifyPlan, so that isn't a change of the admin credentials:
+	passwordWriteOnly, diags := rosa.AdminPasswordWriteOnlyFromConfig(ctx, request.Config)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if !passwordWriteOnly.IsNull() {
+		state.AdminCredentials = rosaTypes.AdminCredentialsWithoutPassword(ctx, state.AdminCredentials)
+	}
+
 	//assert no changes on specific attributes
 	diags = validateNoImmutableAttChange(state, plan)
 	if diags.HasError() {
@@ -1556,6 +1656,17 @@ func (r *ClusterRosaHcpResource) Update(ctx context.Context, request resource.Up
 		return
 	}
 
+	if rosa.ShouldRotateAdminPassword(state.AdminPasswordWoVersion, plan.AdminPasswordWoVersion) {
+		if err := r.rotateAdminPassword(ctx, state, request.Config); err != nil {
+			response.Diagnostics.AddError(
+				"Can't rotate admin password",
+				fmt.Sprintf("Can't rotate admin password of cluster with identifier: `%s`, %v", state.ID.ValueString(), err),
+			)
+			return
+		}
+		state.AdminPasswordWoVersion = plan.AdminPasswordWoVersion
+	}
+
 	_, shouldPatchDeleteProtection := common.ShouldPatchBool(state.DeleteProtection, plan.DeleteProtection)
 	desiredDeleteProtection := plan.DeleteProtection
 
@@ -1605,7 +1716,7 @@ func (r *ClusterRosaHcpResource) Update(ctx context.Context, request resource.Up
 		return
 	}
 
-	patchProperties := shouldPatchProperties(state, plan)
+	patchProperties := shouldPatchProperties(state, plan, r.DefaultProperties)
 	if patchProperties {
 		propertiesElements, err := rosa.ValidatePatchProperties(ctx, state.Properties, plan.Properties)
 		if err != nil {
@@ -1614,7 +1725,22 @@ func (r *ClusterRosaHcpResource) Update(ctx context.Context, request resource.Up
 				fmt.Sprintf("Shouldn't patch cluster with identifier: '%s', %v", state.ID.ValueString(), err),
 			)
 		}
+		if propertiesElements == nil && rosa.DefaultPropertiesMissing(ctx, state.OCMProperties, r.DefaultProperties) {
+			// Only the default properties changed, so keep the ones of the user:
+			propertiesElements, err = common.OptionalMap(ctx, state.Properties)
+			if err != nil {
+				response.Diagnostics.AddError(
+					"Can't patch cluster",
+					fmt.Sprintf("Can't read properties of cluster with identifier: '%s', %v", state.ID.ValueString(), err),
+				)
+				return
+			}
+			if propertiesElements == nil {
+				propertiesElements = map[string]string{}
+			}
+		}
 		if propertiesElements != nil {
+			propertiesElements = common.MergeDefaults(r.DefaultProperties, propertiesElements)
 			maps.Copy(propertiesElements, rosa.OCMProperties)
 			clusterBuilder.Properties(propertiesElements)
 		}
@@ -1724,7 +1850,7 @@ func (r *ClusterRosaHcpResource) Update(ctx context.Context, request resource.Up
 	}
 
 	// Update the state:
-	err = populateRosaHcpClusterState(ctx, object, plan)
+	err = populateRosaHcpClusterState(ctx, object, plan, r.DefaultProperties)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Can't populate cluster state",
@@ -1787,6 +1913,22 @@ func (r *ClusterRosaHcpResource) Update(ctx context.Context, request resource.Up
 	response.Diagnostics.Append(diags...)
 }
 
+// Sets the password of the cluster admin user to the write-only password of
+// the configuration
+func (r *ClusterRosaHcpResource) rotateAdminPassword(ctx context.Context, state *ClusterRosaHcpState,
+	config tfsdk.Config) error {
+	password, diags := rosa.AdminPasswordWriteOnlyFromConfig(ctx, config)
+	if diags.HasError() {
+		return fmt.Errorf("failed to read '%s': %v", rosa.AdminPasswordWriteOnlyAttribute, diags.Errors())
+	}
+	username, _ := rosaTypes.ExpandAdminCredentials(ctx, state.AdminCredentials, diags)
+	if username == "" {
+		username = commonutils.ClusterAdminUsername
+	}
+	return rosa.RotateAdminPassword(ctx, r.ClusterCollection.Cluster(state.ID.ValueString()),
+		username, password.ValueString())
+}
+
 // Upgrades the cluster if the desired (plan) version is greater than the
 // current version
 func (r *ClusterRosaHcpResource) upgradeClusterIfNeeded(ctx context.Context, state, plan *ClusterRosaHcpState) error {
@@ -1858,6 +2000,21 @@ func (r *ClusterRosaHcpResource) upgradeClusterIfNeeded(ctx context.Context, sta
 		}
 	}
 
+	// Wait for the upgrade to finish if requested
+	if !cancelingUpgradeOnly && common.BoolWithFalseDefault(plan.WaitForUpgradeComplete) {
+		upgradeTimeout, diags := plan.Timeouts.Update(ctx, common.DefaultUpgradeTimeout)
+		if diags.HasError() {
+			return fmt.Errorf("failed to get the update timeout: %v", diags.Errors())
+		}
+		err = common.WaitForUpgrade(ctx, fmt.Sprintf("cluster '%s'", state.ID.ValueString()), upgradeTimeout,
+			r.PollingInterval, func(ctx context.Context) (*common.UpgradeProgress, error) {
+				return upgrade.GetUpgradeProgress(ctx, r.ClusterCollection, state.ID.ValueString(), desiredVersion)
+			})
+		if err != nil {
+			return err
+		}
+	}
+
 	state.Version = plan.Version
 	state.UpgradeAcksFor = plan.UpgradeAcksFor
 	return nil
@@ -1915,6 +2072,21 @@ func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient, clusterID
 		}
 	}
 	targetMinorVersion := getOcmVersionMinor(desiredVersion.String())
+	agreed := map[string]bool{}
+	if userAckRequired && userAckString != targetMinorVersion {
+		// Gates that were acknowledged beforehand, for example with the
+		// 'rhcs_version_gate_agreement' resource, don't need the user ack
+		agreed, err = common.AgreedVersionGates(ctx, clusterClient.GateAgreements())
+		if err != nil {
+			return err
+		}
+		userAckRequired = false
+		for _, gate := range gates {
+			if !gate.STSOnly() && !agreed[gate.ID()] {
+				userAckRequired = true
+			}
+		}
+	}
 	if userAckRequired && userAckString != targetMinorVersion { // User has not acknowledged mandatory gates, stop here.
 		return fmt.Errorf("%s\nTo acknowledge these items, please add \"upgrade_acknowledgements_for = %s\""+
 			" and re-apply the changes", description, targetMinorVersion)
@@ -1923,9 +2095,12 @@ func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient, clusterID
 	// Ack all gates to OCM
 	for _, gate := range gates {
 		gateID := gate.ID()
+		if agreed[gateID] {
+			continue
+		}
 		tflog.Debug(ctx, "Acknowledging version gate", map[string]any{"gateID": gateID})
 		gateAgreementsClient := clusterClient.GateAgreements()
-		err := upgrade.AckVersionGate(gateAgreementsClient, gateID)
+		_, err := upgrade.AckVersionGate(gateAgreementsClient, gateID)
 		if err != nil {
 			return fmt.Errorf("failed to acknowledge version gate '%s' for cluster '%s': %v",
 				gateID, clusterID, err)
@@ -2017,6 +2192,12 @@ func (r *ClusterRosaHcpResource) Delete(ctx context.Context, request resource.De
 				timeout = state.DestroyTimeout.ValueInt64()
 			}
 		}
+		deleteTimeout, diags := state.Timeouts.Delete(ctx, time.Duration(timeout)*time.Minute)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		timeout = common.DurationToMinutes(deleteTimeout)
 		isNotFound, err := r.retryClusterNotFoundWithTimeout(3, 1*time.Minute, ctx, timeout, clusterClient)
 		if err != nil {
 			response.Diagnostics.AddError(
@@ -2050,7 +2231,7 @@ func (r *ClusterRosaHcpResource) ImportState(ctx context.Context, request resour
 }
 
 // populateRosaHcpClusterState copies the data from the API object to the Terraform state.
-func populateRosaHcpClusterState(ctx context.Context, object *cmv1.Cluster, state *ClusterRosaHcpState) error {
+func populateRosaHcpClusterState(ctx context.Context, object *cmv1.Cluster, state *ClusterRosaHcpState, defaultProperties map[string]string) error {
 	state.ID = types.StringValue(object.ID())
 	state.ExternalID = types.StringValue(object.ExternalID())
 	object.API()
@@ -2067,6 +2248,13 @@ func populateRosaHcpClusterState(ctx context.Context, object *cmv1.Cluster, stat
 				propertiesMap[k] = v
 			}
 		}
+		// The default properties of the provider only appear in 'properties' when the user set
+		// them explicitly, otherwise they would show up as drift:
+		explicitProperties, err := common.OptionalMap(ctx, state.Properties)
+		if err != nil {
+			return err
+		}
+		propertiesMap = common.FilterDefaults(propertiesMap, defaultProperties, explicitProperties)
 		mapValue, err := common.ConvertStringMapToMapType(propertiesMap)
 		if err != nil {
 			return err
@@ -2173,7 +2361,7 @@ func populateRosaHcpClusterState(ctx context.Context, object *cmv1.Cluster, stat
 		if state.Sts == nil {
 			state.Sts = &sts.HcpSts{}
 		}
-		oidcEndpointUrl := strings.TrimPrefix(stsState.OIDCEndpointURL(), "https://")
+		oidcEndpointUrl := common.OIDCEndpointURL(stsState.OIDCEndpointURL())
 
 		state.Sts.OIDCEndpointURL = types.StringValue(oidcEndpointUrl)
 		state.Sts.RoleARN = types.StringValue(stsState.RoleARN())
@@ -2523,15 +2711,24 @@ func getAutoNodeRoleARN(autoNode *AutoNode) types.String {
 	return autoNode.RoleARN
 }
 
-func shouldPatchProperties(state, plan *ClusterRosaHcpState) bool {
+func shouldPatchProperties(state, plan *ClusterRosaHcpState, defaultProperties map[string]string) bool {
 	// User defined properties needs update
 	if _, should := common.ShouldPatchMap(state.Properties, plan.Properties); should {
 		return true
 	}
 
+	// Default properties of the provider need update
+	if rosa.DefaultPropertiesMissing(context.Background(), state.OCMProperties, defaultProperties) {
+		return true
+	}
+
 	extractedDefaults := map[string]string{}
 	for k, v := range state.OCMProperties.Elements() {
-		if _, ok := state.Properties.Elements()[k]; !ok {
+		if _, ok := state.Properties.Elements()[k]; ok {
+			continue
+		}
+		// The default properties of the provider were already checked above:
+		if _, ok := defaultProperties[k]; !ok {
 			extractedDefaults[k] = v.(types.String).ValueString()
 		}
 	}
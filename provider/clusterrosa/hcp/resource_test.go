@@ -185,7 +185,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 	Context("createHcpClusterObject", func() {
 		It("Creates a cluster with correct field values", func() {
 			clusterState := generateBasicRosaHcpClusterState()
-			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(rosaClusterObject.Name()).To(Equal(clusterName))
@@ -218,7 +218,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 		It("Sets audit log ARN on AWS builder when provided", func() {
 			clusterState := generateBasicRosaHcpClusterState()
 			clusterState.AuditLogArn = types.StringValue(auditLogRoleArn)
-			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			auditLog, ok := rosaClusterObject.AWS().GetAuditLog()
@@ -228,7 +228,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 		It("Sets FIPS on cluster builder when provided", func() {
 			clusterState := generateBasicRosaHcpClusterState()
 			clusterState.FIPS = types.BoolValue(fipsEnabled)
-			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(rosaClusterObject.FIPS()).To(BeTrue())
@@ -239,7 +239,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 				Mode:    types.StringValue(autoNodeModeEnabled),
 				RoleARN: types.StringValue(autoNodeRoleArn),
 			}
-			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			// AutoNode mode and role_arn are not set in the create payload
@@ -254,21 +254,21 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 	It("Throws an error when version format is invalid", func() {
 		clusterState := generateBasicRosaHcpClusterState()
 		clusterState.Version = types.StringValue("a.4.1")
-		_, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+		_, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 		Expect(err).To(HaveOccurred())
 	})
 
 	It("Throws an error when version is unsupported", func() {
 		clusterState := generateBasicRosaHcpClusterState()
 		clusterState.Version = types.StringValue("4.1.0")
-		_, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+		_, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 		Expect(err).To(HaveOccurred())
 	})
 
 	It("appends the non-default channel name to the requested version", func() {
 		clusterState := generateBasicRosaHcpClusterState()
 		clusterState.ChannelGroup = types.StringValue("somechannel")
-		rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+		rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 		Expect(err).ToNot(HaveOccurred())
 
 		version, ok := rosaClusterObject.Version().GetID()
@@ -288,7 +288,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 
 			clusterObject, err := cmv1.UnmarshalCluster(clusterJsonString)
 			Expect(err).ToNot(HaveOccurred())
-			Expect(populateRosaHcpClusterState(context.Background(), clusterObject, clusterState)).To(Succeed())
+			Expect(populateRosaHcpClusterState(context.Background(), clusterObject, clusterState, nil)).To(Succeed())
 
 			Expect(clusterState.ID.ValueString()).To(Equal(clusterId))
 			Expect(clusterState.CloudRegion.ValueString()).To(Equal(regionId))
@@ -334,7 +334,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 			clusterObject, err := cmv1.UnmarshalCluster(clusterJsonString)
 			Expect(err).ToNot(HaveOccurred())
 
-			err = populateRosaHcpClusterState(context.Background(), clusterObject, clusterState)
+			err = populateRosaHcpClusterState(context.Background(), clusterObject, clusterState, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(clusterState.Sts.OIDCEndpointURL.ValueString()).To(Equal("nonce.com"))
 		})
@@ -349,7 +349,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 			clusterObject, err := cmv1.UnmarshalCluster(clusterJsonString)
 			Expect(err).ToNot(HaveOccurred())
 
-			err = populateRosaHcpClusterState(context.Background(), clusterObject, clusterState)
+			err = populateRosaHcpClusterState(context.Background(), clusterObject, clusterState, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(clusterState.Sts.Thumbprint.ValueString()).To(Equal(""))
 		})
@@ -365,7 +365,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 			clusterObject, err := cmv1.UnmarshalCluster(clusterJsonString)
 			Expect(err).ToNot(HaveOccurred())
 
-			err = populateRosaHcpClusterState(context.Background(), clusterObject, clusterState)
+			err = populateRosaHcpClusterState(context.Background(), clusterObject, clusterState, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(clusterState.AuditLogArn.ValueString()).To(Equal(auditLogRoleArn))
 		})
@@ -379,7 +379,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 			clusterObject, err := cmv1.UnmarshalCluster(clusterJsonString)
 			Expect(err).ToNot(HaveOccurred())
 
-			err = populateRosaHcpClusterState(context.Background(), clusterObject, clusterState)
+			err = populateRosaHcpClusterState(context.Background(), clusterObject, clusterState, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(clusterState.FIPS.ValueBool()).To(BeTrue())
 		})
@@ -398,7 +398,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 			clusterObject, err := cmv1.UnmarshalCluster(clusterJsonString)
 			Expect(err).ToNot(HaveOccurred())
 
-			err = populateRosaHcpClusterState(context.Background(), clusterObject, clusterState)
+			err = populateRosaHcpClusterState(context.Background(), clusterObject, clusterState, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(clusterState.AutoNode).NotTo(BeNil())
 			Expect(clusterState.AutoNode.Mode.ValueString()).To(Equal(autoNodeModeEnabled))
@@ -416,7 +416,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 			clusterObject, err := cmv1.UnmarshalCluster(clusterJsonString)
 			Expect(err).ToNot(HaveOccurred())
 
-			err = populateRosaHcpClusterState(context.Background(), clusterObject, clusterState)
+			err = populateRosaHcpClusterState(context.Background(), clusterObject, clusterState, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(clusterState.AutoNode).To(BeNil())
 		})
@@ -432,7 +432,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 			clusterObject, err := cmv1.UnmarshalCluster(clusterJsonString)
 			Expect(err).ToNot(HaveOccurred())
 
-			err = populateRosaHcpClusterState(context.Background(), clusterObject, clusterState)
+			err = populateRosaHcpClusterState(context.Background(), clusterObject, clusterState, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(clusterState.AutoNode).To(BeNil())
 		})
@@ -450,7 +450,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 				RoleARN: types.StringValue(autoNodeRoleArn),
 			}
 
-			err = populateRosaHcpClusterState(context.Background(), clusterObject, clusterState)
+			err = populateRosaHcpClusterState(context.Background(), clusterObject, clusterState, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(clusterState.AutoNode).To(BeNil())
 
@@ -475,7 +475,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 			clusterObject, err := cmv1.UnmarshalCluster(clusterJsonString)
 			Expect(err).ToNot(HaveOccurred())
 
-			err = populateRosaHcpClusterState(context.Background(), clusterObject, clusterState)
+			err = populateRosaHcpClusterState(context.Background(), clusterObject, clusterState, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(clusterState.Channel.ValueString()).To(Equal("stable-4.15"))
@@ -496,7 +496,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 			clusterObject, err := cmv1.UnmarshalCluster(clusterJsonString)
 			Expect(err).ToNot(HaveOccurred())
 
-			err = populateRosaHcpClusterState(context.Background(), clusterObject, clusterState)
+			err = populateRosaHcpClusterState(context.Background(), clusterObject, clusterState, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(clusterState.Channel.IsNull()).To(BeTrue())
@@ -533,7 +533,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 	Context("create cluster admin user", func() {
 		It("No cluster admin user created", func() {
 			clusterState := generateBasicRosaHcpClusterState()
-			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 			Expect(err).To(BeNil())
 			idp := rosaClusterObject.Htpasswd()
 			Expect(idp).To(BeZero())
@@ -541,7 +541,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 		It("Cluster admin user is created with create_admin_user", func() {
 			clusterState := generateBasicRosaHcpClusterState()
 			clusterState.CreateAdminUser = types.BoolValue(true)
-			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 			Expect(err).To(BeNil())
 			idp := rosaClusterObject.Htpasswd()
 			Expect(idp).NotTo(BeZero())
@@ -555,7 +555,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 			username := "test-username"
 			clusterState := generateBasicRosaHcpClusterState()
 			clusterState.AdminCredentials = rosaTypes.FlattenAdminCredentials(username, "")
-			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 			Expect(err).To(BeNil())
 			idp := rosaClusterObject.Htpasswd()
 			Expect(idp).NotTo(BeZero())
@@ -610,7 +610,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 
 			clusterState.LogForwardersAtClusterCreation = logForwarders
 
-			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			controlPlane, ok := rosaClusterObject.GetControlPlane()
@@ -641,7 +641,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 				},
 			})
 
-			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			controlPlane, ok := rosaClusterObject.GetControlPlane()
@@ -658,7 +658,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 		It("Sets network type to Other when no_cni is true", func() {
 			clusterState := generateBasicRosaHcpClusterState()
 			clusterState.NoCNI = types.BoolValue(true)
-			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			networkType, ok := rosaClusterObject.Network().GetType()
@@ -668,7 +668,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 		It("Does not set network type when no_cni is false", func() {
 			clusterState := generateBasicRosaHcpClusterState()
 			clusterState.NoCNI = types.BoolValue(false)
-			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			_, ok := rosaClusterObject.Network().GetType()
@@ -685,7 +685,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 
 			clusterObject, err := cmv1.UnmarshalCluster(clusterJsonString)
 			Expect(err).ToNot(HaveOccurred())
-			Expect(populateRosaHcpClusterState(context.Background(), clusterObject, clusterState)).To(Succeed())
+			Expect(populateRosaHcpClusterState(context.Background(), clusterObject, clusterState, nil)).To(Succeed())
 
 			Expect(clusterState.NoCNI.ValueBool()).To(BeFalse())
 		})
@@ -700,7 +700,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 
 			clusterObject, err := cmv1.UnmarshalCluster(clusterJsonString)
 			Expect(err).ToNot(HaveOccurred())
-			Expect(populateRosaHcpClusterState(context.Background(), clusterObject, clusterState)).To(Succeed())
+			Expect(populateRosaHcpClusterState(context.Background(), clusterObject, clusterState, nil)).To(Succeed())
 
 			Expect(clusterState.NoCNI.ValueBool()).To(BeTrue())
 		})
@@ -748,7 +748,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 			clusterState.ChannelGroup = types.StringNull()
 			clusterState.Version = types.StringNull()
 
-			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			channel, ok := rosaClusterObject.GetChannel()
@@ -762,7 +762,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 			clusterState.ChannelGroup = types.StringNull()
 			clusterState.Version = types.StringValue("4.14.5")
 
-			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+			rosaClusterObject, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			channel, ok := rosaClusterObject.GetChannel()
@@ -780,7 +780,7 @@ var _ = Describe("Rosa HCP Sts cluster", func() {
 			clusterState.ChannelGroup = types.StringNull()
 			clusterState.Version = types.StringNull()
 
-			_, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{})
+			_, err := createHcpClusterObject(context.Background(), clusterState, diag.Diagnostics{}, nil)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("Channel stable-4.10 is not supported"))
 			Expect(err.Error()).To(ContainSubstring("minimal supported version is 4.12.0"))
@@ -4,6 +4,7 @@
 package hcp
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	sharedvpc "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/hcp/shared_vpc"
@@ -44,6 +45,7 @@ type ClusterRosaHcpState struct {
 	AuditLogArn                          types.String `tfsdk:"audit_log_arn"`
 	SpotTerminationQueueUrl              types.String `tfsdk:"spot_termination_queue_url"`
 	Tags                                 types.Map    `tfsdk:"tags"`
+	TagsAll                              types.Map    `tfsdk:"tags_all"`
 	AWSAdditionalComputeSecurityGroupIds types.List   `tfsdk:"aws_additional_compute_security_group_ids"`
 	AWSAdditionalAllowedPrincipals       types.List   `tfsdk:"aws_additional_allowed_principals"`
 	AutoNode                             *AutoNode    `tfsdk:"auto_node"`
@@ -77,12 +79,15 @@ type ClusterRosaHcpState struct {
 	DestroyTimeout                     types.Int64 `tfsdk:"destroy_timeout"`
 	WaitForCreateComplete              types.Bool  `tfsdk:"wait_for_create_complete"`
 	WaitForStdComputeNodesComplete     types.Bool  `tfsdk:"wait_for_std_compute_nodes_complete"`
+	WaitForUpgradeComplete             types.Bool  `tfsdk:"wait_for_upgrade_complete"`
 	MaxHCPClusterWaitTimeoutInMinutes  types.Int64 `tfsdk:"max_hcp_cluster_wait_timeout_in_minutes"`
 	MaxMachinePoolWaitTimeoutInMinutes types.Int64 `tfsdk:"max_machinepool_wait_timeout_in_minutes"`
 
 	// Admin user fields
-	CreateAdminUser  types.Bool   `tfsdk:"create_admin_user"`
-	AdminCredentials types.Object `tfsdk:"admin_credentials"`
+	CreateAdminUser        types.Bool   `tfsdk:"create_admin_user"`
+	AdminCredentials       types.Object `tfsdk:"admin_credentials"`
+	AdminPasswordWo        types.String `tfsdk:"admin_password_wo"`
+	AdminPasswordWoVersion types.Int64  `tfsdk:"admin_password_wo_version"`
 
 	// Registry config fields
 	RegistryConfig *registry_config.RegistryConfig `tfsdk:"registry_config"`
@@ -102,4 +107,6 @@ type ClusterRosaHcpState struct {
 
 	// Delete protection
 	DeleteProtection types.Bool `tfsdk:"delete_protection"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
@@ -35,6 +35,37 @@ type ControlPlaneUpgrade struct {
 	PolicyState *cmv1.UpgradePolicyState
 }
 
+// IsRecurring reports whether the upgrade is driven by a recurring (automatic)
+// policy rather than pinned to a version
+func (u ControlPlaneUpgrade) IsRecurring() bool {
+	return u.Policy.ScheduleType() == cmv1.ScheduleTypeAutomatic
+}
+
+// Find the recurring upgrade policy in the given list of upgrades. OCM allows
+// at most one of them per cluster, so the first match is returned, or nil if
+// there is none
+func FindRecurringUpgrade(upgrades []ControlPlaneUpgrade) *ControlPlaneUpgrade {
+	for i := range upgrades {
+		if upgrades[i].IsRecurring() {
+			return &upgrades[i]
+		}
+	}
+	return nil
+}
+
+// Compare a recurring upgrade policy with the desired schedule, returning the
+// list of attributes that differ so callers can report the drift
+func RecurringUpgradeDrift(upgrade ControlPlaneUpgrade, schedule string, enableMinorVersionUpgrades bool) []string {
+	drift := []string{}
+	if upgrade.Policy.Schedule() != schedule {
+		drift = append(drift, "schedule")
+	}
+	if upgrade.Policy.EnableMinorVersionUpgrades() != enableMinorVersionUpgrades {
+		drift = append(drift, "enable_minor_version_upgrades")
+	}
+	return drift
+}
+
 // Get the available upgrade versions that are reachable from a given starting
 // version
 func GetAvailableUpgradeVersions(ctx context.Context, clustersClient *cmv1.ClustersClient, versionClient *cmv1.VersionsClient, clusterId string) ([]*cmv1.Version, error) {
@@ -123,8 +154,9 @@ func CheckAndCancelUpgrades(
 		// Recurring (automatic) upgrade policies have no pinned version - OCM
 		// selects the target version at runtime - so Version() is empty. Skip
 		// them rather than failing semver parsing (issue #1186); they are not a
-		// pending pinned upgrade that needs reconciling against desiredVersion.
-		if upgrade.Policy.Version() == "" {
+		// pending pinned upgrade that needs reconciling against desiredVersion,
+		// and are managed by the rhcs_hcp_cluster_upgrade_policy resource.
+		if upgrade.IsRecurring() || upgrade.Policy.Version() == "" {
 			tflog.Debug(ctx, "Skipping recurring upgrade policy with no pinned version")
 			continue
 		}
@@ -156,20 +188,57 @@ func CheckAndCancelUpgrades(
 	return correctUpgradePending, nil
 }
 
+// Get the progress of the upgrade of the control plane to the given version.
+// The upgrade policy is removed once the upgrade finishes, so when there is
+// no policy for the version the current version of the cluster is checked
+func GetUpgradeProgress(ctx context.Context, client *cmv1.ClustersClient, clusterId string,
+	desiredVersion *semver.Version) (*common.UpgradeProgress, error) {
+	upgrades, err := GetScheduledUpgrades(ctx, client, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	for _, upgrade := range upgrades {
+		if upgrade.IsRecurring() || upgrade.Policy.Version() == "" {
+			continue
+		}
+		toVersion, err := semver.NewVersion(upgrade.Policy.Version())
+		if err != nil || !desiredVersion.Equal(toVersion) {
+			continue
+		}
+		return &common.UpgradeProgress{
+			Completed:   upgrade.PolicyState.Value() == cmv1.UpgradePolicyStateValueCompleted,
+			State:       upgrade.PolicyState.Value(),
+			Description: upgrade.PolicyState.Description(),
+		}, nil
+	}
+	resp, err := client.Cluster(clusterId).Get().SendContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster: %v", err)
+	}
+	currentVersion, err := semver.NewVersion(resp.Body().Version().RawID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse current cluster version: %v", err)
+	}
+	return &common.UpgradeProgress{
+		Completed: !currentVersion.LessThan(desiredVersion),
+	}, nil
+}
+
+// Acknowledge the given version gate, returning the created agreement
 func AckVersionGate(
 	gateAgreementsClient *cmv1.VersionGateAgreementsClient,
-	gateID string) error {
+	gateID string) (*cmv1.VersionGateAgreement, error) {
 	agreement, err := cmv1.NewVersionGateAgreement().
 		VersionGate(cmv1.NewVersionGate().ID(gateID)).
 		Build()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	response, err := gateAgreementsClient.Add().Body(agreement).Send()
 	if err != nil {
-		return common.HandleErr(response.Error(), err)
+		return nil, common.HandleErr(response.Error(), err)
 	}
-	return nil
+	return response.Body(), nil
 }
 
 // Construct a list of missing gate agreements for upgrade to a given cluster version
@@ -97,3 +97,54 @@ var _ = Describe("CheckAndCancelUpgrades", func() {
 		Expect(err).To(HaveOccurred())
 	})
 })
+
+var _ = Describe("Recurring upgrade policies", func() {
+	newRecurringUpgrade := func(schedule string, enableMinorVersionUpgrades bool) ControlPlaneUpgrade {
+		policy, err := cmv1.NewControlPlaneUpgradePolicy().
+			ID("policy-1").
+			ScheduleType(cmv1.ScheduleTypeAutomatic).
+			Schedule(schedule).
+			EnableMinorVersionUpgrades(enableMinorVersionUpgrades).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		policyState, err := cmv1.NewUpgradePolicyState().Value(cmv1.UpgradePolicyStateValueScheduled).Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		return ControlPlaneUpgrade{Policy: policy, PolicyState: policyState}
+	}
+
+	It("finds the recurring policy among pinned ones", func() {
+		pinned, err := cmv1.NewControlPlaneUpgradePolicy().
+			ID("policy-0").
+			ScheduleType(cmv1.ScheduleTypeManual).
+			Version("4.21.20").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		upgrades := []ControlPlaneUpgrade{
+			{Policy: pinned},
+			newRecurringUpgrade("0 2 * * 0", false),
+		}
+
+		recurring := FindRecurringUpgrade(upgrades)
+
+		Expect(recurring).ToNot(BeNil())
+		Expect(recurring.Policy.ID()).To(Equal("policy-1"))
+	})
+
+	It("returns nil when there is no recurring policy", func() {
+		Expect(FindRecurringUpgrade([]ControlPlaneUpgrade{})).To(BeNil())
+	})
+
+	It("reports no drift when the policy matches", func() {
+		drift := RecurringUpgradeDrift(newRecurringUpgrade("0 2 * * 0", true), "0 2 * * 0", true)
+
+		Expect(drift).To(BeEmpty())
+	})
+
+	It("reports the attributes that drifted", func() {
+		drift := RecurringUpgradeDrift(newRecurringUpgrade("0 2 * * 0", false), "0 4 * * 6", true)
+
+		Expect(drift).To(ConsistOf("schedule", "enable_minor_version_upgrades"))
+	})
+})
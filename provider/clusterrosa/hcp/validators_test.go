@@ -300,7 +300,7 @@ var _ = Describe("shouldPatchProperties", func() {
 			"rosa_creator_arn": types.StringValue("arn:aws:iam::123456789012:user/other"),
 		})
 
-		Expect(shouldPatchProperties(state, plan)).To(BeTrue())
+		Expect(shouldPatchProperties(state, plan, nil)).To(BeTrue())
 	})
 
 	It("returns false when properties and OCM defaults are unchanged", func() {
@@ -312,7 +312,7 @@ var _ = Describe("shouldPatchProperties", func() {
 		})
 		plan.OCMProperties = state.OCMProperties
 
-		Expect(shouldPatchProperties(state, plan)).To(BeFalse())
+		Expect(shouldPatchProperties(state, plan, nil)).To(BeFalse())
 	})
 
 	It("returns true when OCM default property values drift", func() {
@@ -324,6 +324,20 @@ var _ = Describe("shouldPatchProperties", func() {
 		})
 		plan.OCMProperties = state.OCMProperties
 
-		Expect(shouldPatchProperties(state, plan)).To(BeTrue())
+		Expect(shouldPatchProperties(state, plan, nil)).To(BeTrue())
+	})
+
+	It("checks the default properties of the provider", func() {
+		state := cloneBasicState()
+		plan := cloneBasicState()
+		state.OCMProperties = types.MapValueMust(types.StringType, map[string]attr.Value{
+			rosa.PropertyRosaTfVersion: types.StringValue(rosa.OCMProperties[rosa.PropertyRosaTfVersion]),
+			rosa.PropertyRosaTfCommit:  types.StringValue(rosa.OCMProperties[rosa.PropertyRosaTfCommit]),
+			"owner":                    types.StringValue("team"),
+		})
+		plan.OCMProperties = state.OCMProperties
+
+		Expect(shouldPatchProperties(state, plan, map[string]string{"owner": "team"})).To(BeFalse())
+		Expect(shouldPatchProperties(state, plan, map[string]string{"owner": "other-team"})).To(BeTrue())
 	})
 })
@@ -9,14 +9,14 @@ import (
 	"fmt"
 	"net/url"
 	"os"
-	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
-	awsarn "github.com/aws/aws-sdk-go-v2/aws/arn"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/openshift-online/ocm-common/pkg/aws/ststrust"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 )
 
 // TrustPolicyValidatorFunc validates trust_policy_external_id against installer and support IAM role trust policies.
@@ -208,25 +208,11 @@ func (l *iamTrustPolicyLoader) trustPolicyJSONForRoleARN(ctx context.Context, ro
 // roleNameFromARN extracts the IAM role name from a role ARN for use with GetRole.
 // IAM role names are unique per account; GetRole expects the name without the path prefix.
 func roleNameFromARN(roleARN string) (string, error) {
-	parsed, err := awsarn.Parse(roleARN)
+	parsed, err := common.ParseRoleARN(roleARN)
 	if err != nil {
-		return "", fmt.Errorf("invalid role ARN %q: %w", roleARN, err)
-	}
-	const rolePrefix = "role/"
-	if !strings.HasPrefix(parsed.Resource, rolePrefix) {
-		return "", fmt.Errorf("invalid role ARN %q: expected IAM role resource", roleARN)
-	}
-	roleResource := parsed.Resource[len(rolePrefix):]
-	if roleResource == "" {
-		return "", fmt.Errorf("invalid role ARN %q: missing role name", roleARN)
-	}
-	if idx := strings.LastIndex(roleResource, "/"); idx >= 0 {
-		roleResource = roleResource[idx+1:]
-	}
-	if roleResource == "" {
-		return "", fmt.Errorf("invalid role ARN %q: missing role name", roleARN)
+		return "", err
 	}
-	return roleResource, nil
+	return parsed.Name, nil
 }
 
 // trustPolicyJSONFromRole decodes the assume-role policy document attached to an IAM role.
@@ -0,0 +1,273 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+const (
+	topologyHcp     = "hcp"
+	topologyClassic = "classic"
+)
+
+type ClustersDataSource struct {
+	collection *cmv1.ClustersClient
+}
+
+var _ datasource.DataSource = &ClustersDataSource{}
+var _ datasource.DataSourceWithConfigure = &ClustersDataSource{}
+
+func New() datasource.DataSource {
+	return &ClustersDataSource{}
+}
+
+func (s *ClustersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clusters"
+}
+
+func (s *ClustersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List of clusters visible to the current account. All the given filters must match.",
+		Attributes: map[string]schema.Attribute{
+			"search": schema.StringAttribute{
+				Description: "OCM search expression, for example `product.id = 'rosa' and creation_timestamp > '2024-01-01'`.",
+				Optional:    true,
+			},
+			"order": schema.StringAttribute{
+				Description: "Order criteria, for example `name asc`.",
+				Optional:    true,
+			},
+			"name_pattern": schema.StringAttribute{
+				Description: "Pattern that the name of the clusters must match, where `*` matches any sequence of characters, " +
+					"for example `prod-*`.",
+				Optional: true,
+			},
+			"region": schema.StringAttribute{
+				Description: "AWS region identifier of the clusters, for example 'us-east-1'.",
+				Optional:    true,
+			},
+			"topology": schema.StringAttribute{
+				Description: fmt.Sprintf("Topology of the clusters, either '%s' for hosted control plane clusters or '%s'.",
+					topologyHcp, topologyClassic),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(topologyHcp, topologyClassic),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the clusters, for example 'ready' or 'error'.",
+				Optional:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "OpenShift version of the clusters, where `*` matches any sequence of characters, for example `4.16.*`.",
+				Optional:    true,
+			},
+			"properties": schema.MapAttribute{
+				Description: "Properties that the clusters must have, with the same values.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "AWS tags that the clusters must have, with the same values.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "Clusters that match the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: s.itemAttributes(),
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (s *ClustersDataSource) itemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Unique identifier of the cluster.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the cluster.",
+			Computed:    true,
+		},
+		"state": schema.StringAttribute{
+			Description: "State of the cluster.",
+			Computed:    true,
+		},
+		"version": schema.StringAttribute{
+			Description: "OpenShift version of the cluster, for example '4.16.1'.",
+			Computed:    true,
+		},
+		"api_url": schema.StringAttribute{
+			Description: "URL of the API server.",
+			Computed:    true,
+		},
+		"topology": schema.StringAttribute{
+			Description: fmt.Sprintf("Topology of the cluster, either '%s' or '%s'.", topologyHcp, topologyClassic),
+			Computed:    true,
+		},
+		"region": schema.StringAttribute{
+			Description: "AWS region identifier of the cluster.",
+			Computed:    true,
+		},
+	}
+}
+
+func (s *ClustersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured:
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Cast the provider data to the specific implementation:
+	connection := req.ProviderData.(*common.ProviderData).Connection
+
+	// Get the collection of clusters:
+	s.collection = connection.ClustersMgmt().V1().Clusters()
+}
+
+func (s *ClustersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the state:
+	state := &ClustersState{}
+	diags := req.Config.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	properties, err := common.OptionalMap(ctx, state.Properties)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid properties", err.Error())
+		return
+	}
+	tags, err := common.OptionalMap(ctx, state.Tags)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid tags", err.Error())
+		return
+	}
+
+	// Fetch the list of clusters. The filters that the search language supports are sent to the
+	// server, the properties and the tags are checked here:
+	var listItems []*cmv1.Cluster
+	listSize := 100
+	listPage := 1
+	listRequest := s.collection.List().Size(listSize)
+	if search := buildSearch(state); search != "" {
+		listRequest.Search(search)
+	}
+	if common.HasValue(state.Order) {
+		listRequest.Order(state.Order.ValueString())
+	}
+	for {
+		listResponse, err := listRequest.SendContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Can't list clusters",
+				err.Error(),
+			)
+			return
+		}
+		if listItems == nil {
+			listItems = make([]*cmv1.Cluster, 0, listResponse.Total())
+		}
+		listResponse.Items().Each(func(listItem *cmv1.Cluster) bool {
+			if matchesAll(listItem.Properties(), properties) && matchesAll(listItem.AWS().Tags(), tags) {
+				listItems = append(listItems, listItem)
+			}
+			return true
+		})
+		if listResponse.Size() < listSize {
+			break
+		}
+		listPage++
+		listRequest.Page(listPage)
+	}
+
+	// Populate the state:
+	state.Items = make([]*ClusterState, len(listItems))
+	for i, listItem := range listItems {
+		topology := topologyClassic
+		if listItem.Hypershift().Enabled() {
+			topology = topologyHcp
+		}
+		version := listItem.OpenshiftVersion()
+		if version == "" {
+			version = listItem.Version().RawID()
+		}
+		state.Items[i] = &ClusterState{
+			ID:       types.StringValue(listItem.ID()),
+			Name:     types.StringValue(listItem.Name()),
+			State:    types.StringValue(string(listItem.State())),
+			Version:  types.StringValue(version),
+			APIURL:   types.StringValue(listItem.API().URL()),
+			Topology: types.StringValue(topology),
+			Region:   types.StringValue(listItem.Region().ID()),
+		}
+	}
+
+	// Save the state:
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// buildSearch combines the search expression and the convenience filters into one OCM search
+// expression.
+func buildSearch(state *ClustersState) string {
+	terms := []string{}
+	if common.HasValue(state.Search) && strings.TrimSpace(state.Search.ValueString()) != "" {
+		terms = append(terms, fmt.Sprintf("(%s)", state.Search.ValueString()))
+	}
+	if common.HasValue(state.NamePattern) {
+		terms = append(terms, fmt.Sprintf("name like %s", quote(likePattern(state.NamePattern.ValueString()))))
+	}
+	if common.HasValue(state.Region) {
+		terms = append(terms, fmt.Sprintf("region.id = %s", quote(state.Region.ValueString())))
+	}
+	if common.HasValue(state.Topology) {
+		terms = append(terms, fmt.Sprintf("hypershift.enabled = %s",
+			quote(fmt.Sprint(state.Topology.ValueString() == topologyHcp))))
+	}
+	if common.HasValue(state.State) {
+		terms = append(terms, fmt.Sprintf("state = %s", quote(state.State.ValueString())))
+	}
+	if common.HasValue(state.Version) {
+		terms = append(terms, fmt.Sprintf("openshift_version like %s", quote(likePattern(state.Version.ValueString()))))
+	}
+	return strings.Join(terms, " and ")
+}
+
+// likePattern translates a pattern where '*' matches any sequence of characters into the syntax of
+// the 'like' operator of the search language.
+func likePattern(pattern string) string {
+	return strings.ReplaceAll(pattern, "*", "%")
+}
+
+// quote returns the given value as a string literal of the search language.
+func quote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// matchesAll checks if the given values contain all the wanted keys, with the same values.
+func matchesAll(values, wanted map[string]string) bool {
+	for k, v := range wanted {
+		if current, ok := values[k]; !ok || current != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,29 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package clusters
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type ClustersState struct {
+	Search      types.String    `tfsdk:"search"`
+	Order       types.String    `tfsdk:"order"`
+	NamePattern types.String    `tfsdk:"name_pattern"`
+	Region      types.String    `tfsdk:"region"`
+	Topology    types.String    `tfsdk:"topology"`
+	State       types.String    `tfsdk:"state"`
+	Version     types.String    `tfsdk:"version"`
+	Properties  types.Map       `tfsdk:"properties"`
+	Tags        types.Map       `tfsdk:"tags"`
+	Items       []*ClusterState `tfsdk:"items"`
+}
+
+type ClusterState struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	State    types.String `tfsdk:"state"`
+	Version  types.String `tfsdk:"version"`
+	APIURL   types.String `tfsdk:"api_url"`
+	Topology types.String `tfsdk:"topology"`
+	Region   types.String `tfsdk:"region"`
+}
@@ -0,0 +1,392 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package clusterupgrades
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	semver "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	classicUpgrade "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/classic/upgrade"
+	hcpUpgrade "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/hcp/upgrade"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+	machinePoolUpgrade "github.com/terraform-redhat/terraform-provider-rhcs/provider/machinepool/hcp/upgrade"
+)
+
+type ClusterUpgradesDataSource struct {
+	clusterCollection *cmv1.ClustersClient
+	versionCollection *cmv1.VersionsClient
+}
+
+var _ datasource.DataSource = &ClusterUpgradesDataSource{}
+var _ datasource.DataSourceWithConfigure = &ClusterUpgradesDataSource{}
+
+func New() datasource.DataSource {
+	return &ClusterUpgradesDataSource{}
+}
+
+func (d *ClusterUpgradesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_upgrades"
+}
+
+func (d *ClusterUpgradesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Available upgrades, scheduled upgrades and version gates that need acknowledgement for a " +
+			"cluster, or for a machine pool of a hosted control plane cluster. Finding the missing gates sends a " +
+			"dry run upgrade request for each available version, which doesn't change the cluster.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Identifier of the cluster.",
+				Required:    true,
+			},
+			"machine_pool": schema.StringAttribute{
+				Description: "Identifier of a machine pool of a hosted control plane cluster. When set, the upgrades " +
+					"are the ones of the machine pool instead of the ones of the control plane.",
+				Optional: true,
+			},
+			"current_version": schema.StringAttribute{
+				Description: "Current version of the cluster, or of the machine pool.",
+				Computed:    true,
+			},
+			"available_upgrades": schema.ListNestedAttribute{
+				Description: "Versions that the cluster, or the machine pool, can be upgraded to.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Identifier of the version, for example 'openshift-v4.16.1'.",
+							Computed:    true,
+						},
+						"version": schema.StringAttribute{
+							Description: "Version, for example '4.16.1'.",
+							Computed:    true,
+						},
+						"upgrade_acknowledgements_for": schema.StringAttribute{
+							Description: "Value of the `upgrade_acknowledgements_for` attribute that acknowledges " +
+								"the missing gates of this version.",
+							Computed: true,
+						},
+						"acknowledgement_required": schema.BoolAttribute{
+							Description: "Indicates if some of the missing gates need to be acknowledged by the user.",
+							Computed:    true,
+						},
+						"missing_gates": schema.ListNestedAttribute{
+							Description: "Version gates that haven't been acknowledged yet for this version.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: gateAttributes(),
+							},
+							Computed: true,
+						},
+					},
+				},
+				Computed: true,
+			},
+			"scheduled_upgrades": schema.ListNestedAttribute{
+				Description: "Upgrade policies of the cluster, or of the machine pool.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Identifier of the upgrade policy.",
+							Computed:    true,
+						},
+						"version": schema.StringAttribute{
+							Description: "Version of the upgrade, empty for recurring upgrades.",
+							Computed:    true,
+						},
+						"schedule_type": schema.StringAttribute{
+							Description: "Schedule type, either 'manual' or 'automatic'.",
+							Computed:    true,
+						},
+						"schedule": schema.StringAttribute{
+							Description: "Cron expression of recurring upgrades.",
+							Computed:    true,
+						},
+						"next_run": schema.StringAttribute{
+							Description: "Next time that the upgrade runs, in RFC 3339 format.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "State of the upgrade, for example 'scheduled', 'started' or 'delayed'.",
+							Computed:    true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func gateAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Identifier of the version gate.",
+			Computed:    true,
+		},
+		"label": schema.StringAttribute{
+			Description: "Label of the version gate.",
+			Computed:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "Description of what needs to be acknowledged.",
+			Computed:    true,
+		},
+		"documentation_url": schema.StringAttribute{
+			Description: "URL of the documentation of the version gate.",
+			Computed:    true,
+		},
+		"warning_message": schema.StringAttribute{
+			Description: "Warning message of the version gate.",
+			Computed:    true,
+		},
+		"sts_only": schema.BoolAttribute{
+			Description: "Indicates that the gate only applies to STS clusters and is acknowledged without user input.",
+			Computed:    true,
+		},
+	}
+}
+
+func (d *ClusterUpgradesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured:
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Cast the provider data to the specific implementation:
+	connection := req.ProviderData.(*common.ProviderData).Connection
+
+	// Get the collections of clusters and versions:
+	d.clusterCollection = connection.ClustersMgmt().V1().Clusters()
+	d.versionCollection = connection.ClustersMgmt().V1().Versions()
+}
+
+// upgradeTarget contains the calls that differ between classic clusters, hosted control planes and
+// machine pools.
+type upgradeTarget struct {
+	currentVersion string
+	available      func() ([]*cmv1.Version, error)
+	missingGates   func(version string) ([]*cmv1.VersionGate, error)
+	scheduled      func() ([]*ScheduledUpgrade, error)
+}
+
+func (d *ClusterUpgradesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the state:
+	state := &ClusterUpgradesState{}
+	diags := req.Config.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterId := state.Cluster.ValueString()
+	clusterClient := d.clusterCollection.Cluster(clusterId)
+	getResponse, err := clusterClient.Get().SendContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't find cluster",
+			fmt.Sprintf("Can't find cluster with identifier '%s': %v", clusterId, err),
+		)
+		return
+	}
+	cluster := getResponse.Body()
+
+	var target *upgradeTarget
+	switch {
+	case common.HasValue(state.MachinePool):
+		if !cluster.Hypershift().Enabled() {
+			resp.Diagnostics.AddError(
+				"Invalid machine pool",
+				fmt.Sprintf("Machine pool upgrades are only supported for hosted control plane clusters, "+
+					"and cluster '%s' isn't one", clusterId),
+			)
+			return
+		}
+		target, err = d.machinePoolTarget(ctx, clusterId, state.MachinePool.ValueString())
+	case cluster.Hypershift().Enabled():
+		target = d.hcpTarget(ctx, cluster)
+	default:
+		target = d.classicTarget(ctx, cluster)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Can't get upgrades", err.Error())
+		return
+	}
+
+	availableVersions, err := target.available()
+	if err != nil {
+		resp.Diagnostics.AddError("Can't get available upgrades", err.Error())
+		return
+	}
+	state.CurrentVersion = types.StringValue(target.currentVersion)
+	state.AvailableUpgrades = make([]*AvailableUpgrade, len(availableVersions))
+	for i, version := range availableVersions {
+		gates, err := target.missingGates(version.RawID())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Can't get missing version gates",
+				fmt.Sprintf("Can't get missing version gates for version '%s': %v", version.RawID(), err),
+			)
+			return
+		}
+		available := &AvailableUpgrade{
+			ID:                         types.StringValue(version.ID()),
+			Version:                    types.StringValue(version.RawID()),
+			UpgradeAcknowledgementsFor: types.StringValue(getOcmVersionMinor(version.RawID())),
+			AcknowledgementRequired:    types.BoolValue(false),
+			MissingGates:               make([]*VersionGate, len(gates)),
+		}
+		for j, gate := range gates {
+			available.MissingGates[j] = &VersionGate{
+				ID:               types.StringValue(gate.ID()),
+				Label:            types.StringValue(gate.Label()),
+				Description:      types.StringValue(gate.Description()),
+				DocumentationURL: types.StringValue(gate.DocumentationURL()),
+				WarningMessage:   types.StringValue(gate.WarningMessage()),
+				STSOnly:          types.BoolValue(gate.STSOnly()),
+			}
+			// STS-only gates don't require user acknowledgement
+			if !gate.STSOnly() {
+				available.AcknowledgementRequired = types.BoolValue(true)
+			}
+		}
+		state.AvailableUpgrades[i] = available
+	}
+
+	state.ScheduledUpgrades, err = target.scheduled()
+	if err != nil {
+		resp.Diagnostics.AddError("Can't get scheduled upgrades", err.Error())
+		return
+	}
+
+	// Save the state:
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (d *ClusterUpgradesDataSource) classicTarget(ctx context.Context, cluster *cmv1.Cluster) *upgradeTarget {
+	clusterId := cluster.ID()
+	return &upgradeTarget{
+		currentVersion: cluster.Version().RawID(),
+		available: func() ([]*cmv1.Version, error) {
+			return classicUpgrade.GetAvailableUpgradeVersions(ctx, d.clusterCollection, d.versionCollection, clusterId)
+		},
+		missingGates: func(version string) ([]*cmv1.VersionGate, error) {
+			gates, _, err := classicUpgrade.CheckMissingAgreements(version, clusterId,
+				d.clusterCollection.Cluster(clusterId).UpgradePolicies())
+			return gates, err
+		},
+		scheduled: func() ([]*ScheduledUpgrade, error) {
+			upgrades, err := classicUpgrade.GetScheduledUpgrades(ctx, d.clusterCollection, clusterId)
+			if err != nil {
+				return nil, err
+			}
+			result := make([]*ScheduledUpgrade, len(upgrades))
+			for i, upgrade := range upgrades {
+				scheduleType := cmv1.ScheduleTypeManual
+				if upgrade.IsRecurring() {
+					scheduleType = cmv1.ScheduleTypeAutomatic
+				}
+				result[i] = newScheduledUpgrade(upgrade.ID(), upgrade.Version(), scheduleType,
+					upgrade.Schedule(), upgrade.NextRun(), upgrade.State())
+			}
+			return result, nil
+		},
+	}
+}
+
+func (d *ClusterUpgradesDataSource) hcpTarget(ctx context.Context, cluster *cmv1.Cluster) *upgradeTarget {
+	clusterId := cluster.ID()
+	return &upgradeTarget{
+		currentVersion: cluster.Version().RawID(),
+		available: func() ([]*cmv1.Version, error) {
+			return hcpUpgrade.GetAvailableUpgradeVersions(ctx, d.clusterCollection, d.versionCollection, clusterId)
+		},
+		missingGates: func(version string) ([]*cmv1.VersionGate, error) {
+			gates, _, err := hcpUpgrade.CheckMissingAgreements(version, clusterId,
+				d.clusterCollection.Cluster(clusterId).ControlPlane().UpgradePolicies())
+			return gates, err
+		},
+		scheduled: func() ([]*ScheduledUpgrade, error) {
+			upgrades, err := hcpUpgrade.GetScheduledUpgrades(ctx, d.clusterCollection, clusterId)
+			if err != nil {
+				return nil, err
+			}
+			result := make([]*ScheduledUpgrade, len(upgrades))
+			for i, upgrade := range upgrades {
+				result[i] = newScheduledUpgrade(upgrade.Policy.ID(), upgrade.Policy.Version(),
+					upgrade.Policy.ScheduleType(), upgrade.Policy.Schedule(), upgrade.Policy.NextRun(),
+					upgrade.PolicyState.Value())
+			}
+			return result, nil
+		},
+	}
+}
+
+func (d *ClusterUpgradesDataSource) machinePoolTarget(ctx context.Context, clusterId string,
+	machinePoolId string) (*upgradeTarget, error) {
+	nodePoolClient := d.clusterCollection.Cluster(clusterId).NodePools().NodePool(machinePoolId)
+	getResponse, err := nodePoolClient.Get().SendContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("can't find machine pool with identifier '%s' for cluster '%s': %v",
+			machinePoolId, clusterId, err)
+	}
+	return &upgradeTarget{
+		currentVersion: getResponse.Body().Version().RawID(),
+		available: func() ([]*cmv1.Version, error) {
+			return machinePoolUpgrade.GetAvailableUpgradeVersions(ctx, d.clusterCollection, d.versionCollection,
+				clusterId, machinePoolId)
+		},
+		missingGates: func(version string) ([]*cmv1.VersionGate, error) {
+			gates, _, err := machinePoolUpgrade.CheckMissingAgreements(version, clusterId,
+				nodePoolClient.UpgradePolicies())
+			return gates, err
+		},
+		scheduled: func() ([]*ScheduledUpgrade, error) {
+			upgrades, err := machinePoolUpgrade.GetScheduledUpgrades(ctx, d.clusterCollection, clusterId, machinePoolId)
+			if err != nil {
+				return nil, err
+			}
+			result := make([]*ScheduledUpgrade, len(upgrades))
+			for i, upgrade := range upgrades {
+				result[i] = newScheduledUpgrade(upgrade.Policy.ID(), upgrade.Policy.Version(),
+					upgrade.Policy.ScheduleType(), upgrade.Policy.Schedule(), upgrade.Policy.NextRun(),
+					upgrade.PolicyState.Value())
+			}
+			return result, nil
+		},
+	}, nil
+}
+
+func newScheduledUpgrade(id string, version string, scheduleType cmv1.ScheduleType, schedule string,
+	nextRun time.Time, state cmv1.UpgradePolicyStateValue) *ScheduledUpgrade {
+	result := &ScheduledUpgrade{
+		ID:           types.StringValue(id),
+		Version:      types.StringValue(version),
+		ScheduleType: types.StringValue(string(scheduleType)),
+		Schedule:     types.StringValue(schedule),
+		NextRun:      types.StringNull(),
+		State:        types.StringValue(string(state)),
+	}
+	if !nextRun.IsZero() {
+		result.NextRun = types.StringValue(nextRun.UTC().Format(time.RFC3339))
+	}
+	return result
+}
+
+// TODO: move to ocm commons
+func getOcmVersionMinor(ver string) string {
+	version, err := semver.NewVersion(ver)
+	if err != nil {
+		segments := strings.Split(ver, ".")
+		return fmt.Sprintf("%s.%s", segments[0], segments[1])
+	}
+	segments := version.Segments()
+	return fmt.Sprintf("%d.%d", segments[0], segments[1])
+}
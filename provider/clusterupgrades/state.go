@@ -0,0 +1,40 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package clusterupgrades
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type ClusterUpgradesState struct {
+	Cluster           types.String        `tfsdk:"cluster"`
+	MachinePool       types.String        `tfsdk:"machine_pool"`
+	CurrentVersion    types.String        `tfsdk:"current_version"`
+	AvailableUpgrades []*AvailableUpgrade `tfsdk:"available_upgrades"`
+	ScheduledUpgrades []*ScheduledUpgrade `tfsdk:"scheduled_upgrades"`
+}
+
+type AvailableUpgrade struct {
+	ID                         types.String   `tfsdk:"id"`
+	Version                    types.String   `tfsdk:"version"`
+	UpgradeAcknowledgementsFor types.String   `tfsdk:"upgrade_acknowledgements_for"`
+	AcknowledgementRequired    types.Bool     `tfsdk:"acknowledgement_required"`
+	MissingGates               []*VersionGate `tfsdk:"missing_gates"`
+}
+
+type VersionGate struct {
+	ID               types.String `tfsdk:"id"`
+	Label            types.String `tfsdk:"label"`
+	Description      types.String `tfsdk:"description"`
+	DocumentationURL types.String `tfsdk:"documentation_url"`
+	WarningMessage   types.String `tfsdk:"warning_message"`
+	STSOnly          types.Bool   `tfsdk:"sts_only"`
+}
+
+type ScheduledUpgrade struct {
+	ID           types.String `tfsdk:"id"`
+	Version      types.String `tfsdk:"version"`
+	ScheduleType types.String `tfsdk:"schedule_type"`
+	Schedule     types.String `tfsdk:"schedule"`
+	NextRun      types.String `tfsdk:"next_run"`
+	State        types.String `tfsdk:"state"`
+}
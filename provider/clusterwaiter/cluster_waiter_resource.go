@@ -7,14 +7,15 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
@@ -53,7 +54,8 @@ func (r *ClusterWaiterResource) Schema(ctx context.Context, req resource.SchemaR
 			"timeout": schema.Int64Attribute{
 				Description: "An optional timeout until the cluster is ready. The timeout value is set in minutes." +
 					" The default value is 60 minutes.",
-				Optional: true,
+				DeprecationMessage: common.TimeoutDeprecationMessage("create"),
+				Optional:           true,
 				Validators: []validator.Int64{
 					int64validator.AtLeast(1), // Timeout must be positive
 				},
@@ -64,6 +66,12 @@ func (r *ClusterWaiterResource) Schema(ctx context.Context, req resource.SchemaR
 				Computed: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
 	}
 }
 
@@ -73,17 +81,19 @@ func (r *ClusterWaiterResource) Configure(ctx context.Context, req resource.Conf
 		return
 	}
 
-	connection, ok := req.ProviderData.(*sdk.Connection)
+	providerData, ok := req.ProviderData.(*common.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.Connaction, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
+	connection := providerData.Connection
 
 	r.collection = connection.ClustersMgmt().V1().Clusters()
-	r.clusterWait = common.NewClusterWait(r.collection, connection)
+	r.clusterWait = common.NewClusterWait(r.collection, connection,
+		common.WithPollingInterval(providerData.ClusterWaitPollingInterval))
 }
 
 func (r *ClusterWaiterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -95,13 +105,20 @@ func (r *ClusterWaiterResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	state, err := r.startPolling(ctx, state)
+	timeout, diags := state.Timeouts.Create(ctx, defaultTimeout(state))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := r.startPolling(ctx, state, timeout)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Waiting for cluster creation finished with error",
 			fmt.Sprintf("Waiting for cluster creation finished with the error %v", err),
 		)
+		resp.Diagnostics.Append(common.ClusterWaitDiagnostics(err)...)
 		if state == nil {
 			return
 		}
@@ -124,10 +141,16 @@ func (r *ClusterWaiterResource) Update(ctx context.Context, req resource.UpdateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	state, err := r.startPolling(ctx, plan)
+	timeout, diags := plan.Timeouts.Update(ctx, defaultTimeout(plan))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state, err := r.startPolling(ctx, plan, timeout)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Can't poll cluster state (update resource)", err.Error())
+		resp.Diagnostics.Append(common.ClusterWaitDiagnostics(err)...)
 		return
 	}
 
@@ -140,19 +163,26 @@ func (r *ClusterWaiterResource) Delete(ctx context.Context, req resource.DeleteR
 	resp.State.RemoveResource(ctx)
 }
 
-func (r *ClusterWaiterResource) startPolling(ctx context.Context, state *ClusterWaiterState) (*ClusterWaiterState, error) {
-	state.Ready = types.BoolValue(false)
-
+// defaultTimeout returns the timeout used when the 'timeouts' block doesn't set one, taking into
+// account the deprecated 'timeout' attribute.
+func defaultTimeout(state *ClusterWaiterState) time.Duration {
 	timeout := defaultTimeoutInMinutes
 	if !state.Timeout.IsUnknown() && !state.Timeout.IsNull() {
 		timeout = state.Timeout.ValueInt64()
 	}
+	return time.Duration(timeout) * time.Minute
+}
+
+func (r *ClusterWaiterResource) startPolling(ctx context.Context, state *ClusterWaiterState,
+	timeout time.Duration) (*ClusterWaiterState, error) {
+	state.Ready = types.BoolValue(false)
 
 	// Wait till the cluster is ready:
-	object, err := r.clusterWait.WaitForClusterToBeReady(ctx, state.Cluster.ValueString(), timeout)
+	object, err := r.clusterWait.WaitForClusterToBeReady(ctx, state.Cluster.ValueString(),
+		common.DurationToMinutes(timeout))
 	if err != nil {
 		return state, fmt.Errorf(
-			"Can't poll state of cluster with identifier '%s': %v",
+			"Can't poll state of cluster with identifier '%s': %w",
 			state.Cluster.ValueString(), err,
 		)
 	}
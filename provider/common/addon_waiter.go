@@ -0,0 +1,92 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// DefaultAddOnTimeout is the default time that the add-on resources wait for an add-on to be
+// installed, updated or removed.
+const DefaultAddOnTimeout = time.Hour
+
+// WaitForAddOnInstallation polls the given add-on installation every pollingInterval until it is ready, or until it
+// doesn't exist anymore when deleting is true. It fails when the installation fails or the timeout
+// expires. The returned installation is nil when waiting for the deletion.
+func WaitForAddOnInstallation(ctx context.Context, client *cmv1.AddOnInstallationClient, target string,
+	timeout, pollingInterval time.Duration, deleting bool) (*cmv1.AddOnInstallation, error) {
+	return waitForAddOn(ctx, target, timeout, pollingInterval, deleting, func(ctx context.Context) (*cmv1.AddOnInstallation, error) {
+		getResp, err := client.Get().SendContext(ctx)
+		if err != nil {
+			if getResp != nil && getResp.Status() == http.StatusNotFound {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return getResp.Body(), nil
+	})
+}
+
+// waitForAddOn contains the logic of WaitForAddOnInstallation. The poll function returns a nil
+// installation when it doesn't exist.
+func waitForAddOn(ctx context.Context, target string, timeout, pollingInterval time.Duration, deleting bool,
+	poll func(ctx context.Context) (*cmv1.AddOnInstallation, error)) (*cmv1.AddOnInstallation, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var last *cmv1.AddOnInstallation
+	failures := 0
+	for {
+		installation, err := poll(ctx)
+		switch {
+		case err != nil && ctx.Err() == nil:
+			failures++
+			if failures >= defaultBackoffAttempts {
+				return nil, fmt.Errorf("failed to get the state of %s: %v", target, err)
+			}
+			tflog.Warn(ctx, fmt.Sprintf("Getting the state of %s failed, retrying: %v", target, err))
+		case err == nil && installation == nil:
+			if deleting {
+				tflog.Info(ctx, fmt.Sprintf("Removal of %s completed", target))
+				return nil, nil
+			}
+			return nil, fmt.Errorf("%s doesn't exist", target)
+		case err == nil:
+			failures = 0
+			last = installation
+			if installation.State() == cmv1.AddOnInstallationStateFailed {
+				return nil, fmt.Errorf("%s failed: %s", target, describeAddOnState(installation))
+			}
+			if !deleting && installation.State() == cmv1.AddOnInstallationStateReady {
+				tflog.Info(ctx, fmt.Sprintf("Installation of %s completed", target))
+				return installation, nil
+			}
+			tflog.Info(ctx, fmt.Sprintf("Waiting for %s: %s", target, describeAddOnState(installation)))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%s didn't reach the expected state within %s, the last known state is %s",
+				target, timeout, describeAddOnState(last))
+		case <-time.After(pollingInterval):
+		}
+	}
+}
+
+func describeAddOnState(installation *cmv1.AddOnInstallation) string {
+	state := string(installation.State())
+	if state == "" {
+		state = "unknown"
+	}
+	if installation.StateDescription() == "" {
+		return fmt.Sprintf("'%s'", state)
+	}
+	return fmt.Sprintf("'%s' (%s)", state, installation.StateDescription())
+}
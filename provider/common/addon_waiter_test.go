@@ -0,0 +1,85 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Add-on waiter", func() {
+	installation := func(state cmv1.AddOnInstallationState, description string) *cmv1.AddOnInstallation {
+		object, err := cmv1.NewAddOnInstallation().ID("my-addon").State(state).StateDescription(description).Build()
+		Expect(err).NotTo(HaveOccurred())
+		return object
+	}
+
+	gone := &cmv1.AddOnInstallation{}
+	boom := &cmv1.AddOnInstallation{}
+
+	// sequence returns a poll function that returns the given results in order, repeating the
+	// last one. The 'gone' result means that the installation doesn't exist and 'boom' that the
+	// poll fails.
+	sequence := func(results ...*cmv1.AddOnInstallation) func(context.Context) (*cmv1.AddOnInstallation, error) {
+		calls := 0
+		return func(context.Context) (*cmv1.AddOnInstallation, error) {
+			result := results[min(calls, len(results)-1)]
+			calls++
+			switch result {
+			case gone:
+				return nil, nil
+			case boom:
+				return nil, errors.New("boom")
+			}
+			return result, nil
+		}
+	}
+
+	It("waits until the add-on is ready", func() {
+		result, err := waitForAddOn(context.Background(), "add-on 'my-addon'", time.Minute, time.Millisecond, false, sequence(
+			installation(cmv1.AddOnInstallationStatePending, ""),
+			boom,
+			installation(cmv1.AddOnInstallationStateInstalling, ""),
+			installation(cmv1.AddOnInstallationStateReady, ""),
+		))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.State()).To(Equal(cmv1.AddOnInstallationStateReady))
+	})
+
+	It("waits until the add-on is removed", func() {
+		result, err := waitForAddOn(context.Background(), "add-on 'my-addon'", time.Minute, time.Millisecond, true, sequence(
+			installation(cmv1.AddOnInstallationStateReady, ""),
+			installation(cmv1.AddOnInstallationStateDeleting, ""),
+			gone,
+		))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(BeNil())
+	})
+
+	It("fails when the installation fails", func() {
+		_, err := waitForAddOn(context.Background(), "add-on 'my-addon'", time.Minute, time.Millisecond, false, sequence(
+			installation(cmv1.AddOnInstallationStateInstalling, ""),
+			installation(cmv1.AddOnInstallationStateFailed, "Operator not ready"),
+		))
+		Expect(err).To(MatchError("add-on 'my-addon' failed: 'failed' (Operator not ready)"))
+	})
+
+	It("fails when the add-on disappears while installing", func() {
+		_, err := waitForAddOn(context.Background(), "add-on 'my-addon'", time.Minute, time.Millisecond, false, sequence(gone))
+		Expect(err).To(MatchError("add-on 'my-addon' doesn't exist"))
+	})
+
+	It("fails when the timeout expires", func() {
+		_, err := waitForAddOn(context.Background(), "add-on 'my-addon'", 50*time.Millisecond, time.Millisecond, false, sequence(
+			installation(cmv1.AddOnInstallationStateInstalling, ""),
+		))
+		Expect(err).To(MatchError(ContainSubstring(
+			"didn't reach the expected state within 50ms, the last known state is 'installing'")))
+	})
+})
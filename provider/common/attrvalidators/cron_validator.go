@@ -0,0 +1,122 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package attrvalidators
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// cronField describes the allowed range of one field of a cron expression.
+type cronField struct {
+	name string
+	min  int
+	max  int
+}
+
+// Standard five field cron expression, as accepted by OCM upgrade policies.
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7},
+}
+
+// cronValidator validates that a string Attribute's value is a valid five field cron expression.
+type cronValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v cronValidator) Description(_ context.Context) string {
+	return "value must be a valid cron expression with five fields (minute hour day-of-month month day-of-week)"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v cronValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v cronValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := ValidateCronExpression(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid cron expression",
+			err.Error(),
+		)
+	}
+}
+
+// CronValidator returns a validator which ensures that the configured string
+// is a valid five field cron expression.
+func CronValidator() validator.String {
+	return cronValidator{}
+}
+
+// ValidateCronExpression checks that the given value is a five field cron expression where every
+// field is a '*', a value, a range or a list of those, optionally followed by a '/<step>'.
+func ValidateCronExpression(value string) error {
+	fields := strings.Fields(value)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("cron expression '%s' must have %d fields "+
+			"(minute hour day-of-month month day-of-week), got %d", value, len(cronFields), len(fields))
+	}
+	for i, field := range fields {
+		for _, item := range strings.Split(field, ",") {
+			if err := validateCronItem(item, cronFields[i]); err != nil {
+				return fmt.Errorf("invalid %s field '%s' in cron expression '%s': %v",
+					cronFields[i].name, field, value, err)
+			}
+		}
+	}
+	return nil
+}
+
+func validateCronItem(item string, field cronField) error {
+	base, step, hasStep := strings.Cut(item, "/")
+	if hasStep {
+		stepValue, err := strconv.Atoi(step)
+		if err != nil || stepValue <= 0 {
+			return fmt.Errorf("step '%s' must be a positive number", step)
+		}
+	}
+	if base == "*" {
+		return nil
+	}
+	low, high, isRange := strings.Cut(base, "-")
+	lowValue, err := parseCronValue(low, field)
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		return nil
+	}
+	highValue, err := parseCronValue(high, field)
+	if err != nil {
+		return err
+	}
+	if lowValue > highValue {
+		return fmt.Errorf("range '%s' start is greater than its end", base)
+	}
+	return nil
+}
+
+func parseCronValue(value string, field cronField) (int, error) {
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("'%s' is not a number", value)
+	}
+	if number < field.min || number > field.max {
+		return 0, fmt.Errorf("'%d' is out of range [%d-%d]", number, field.min, field.max)
+	}
+	return number, nil
+}
@@ -0,0 +1,42 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package attrvalidators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cron validator", func() {
+	DescribeTable("should validate correctly",
+		func(value types.String, expectedErr bool) {
+			request := validator.StringRequest{
+				Path:           path.Root("schedule"),
+				PathExpression: path.MatchRoot("schedule"),
+				ConfigValue:    value,
+			}
+			response := validator.StringResponse{}
+			CronValidator().ValidateString(context.Background(), request, &response)
+			Expect(response.Diagnostics.HasError()).To(Equal(expectedErr))
+		},
+		Entry("null value -> ok", types.StringNull(), false),
+		Entry("unknown value -> ok", types.StringUnknown(), false),
+		Entry("every day at midnight -> ok", types.StringValue("0 0 * * *"), false),
+		Entry("sunday at 2:30 -> ok", types.StringValue("30 2 * * 0"), false),
+		Entry("lists, ranges and steps -> ok", types.StringValue("0,30 1-5/2 1,15 */3 1-5"), false),
+		Entry("too few fields -> error", types.StringValue("0 0 * *"), true),
+		Entry("too many fields -> error", types.StringValue("0 0 * * * 2026"), true),
+		Entry("minute out of range -> error", types.StringValue("60 0 * * *"), true),
+		Entry("hour out of range -> error", types.StringValue("0 24 * * *"), true),
+		Entry("day of month zero -> error", types.StringValue("0 0 0 * *"), true),
+		Entry("not a number -> error", types.StringValue("0 0 * JAN *"), true),
+		Entry("inverted range -> error", types.StringValue("0 5-1 * * *"), true),
+		Entry("zero step -> error", types.StringValue("*/0 * * * *"), true),
+	)
+})
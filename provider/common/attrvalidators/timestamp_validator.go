@@ -0,0 +1,31 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package attrvalidators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// RFC3339TimestampValidator returns a validator which ensures that the configured string
+// is a timestamp in RFC3339 format, for example "2026-11-07T22:00:00Z".
+func RFC3339TimestampValidator() validator.String {
+	return NewStringValidator("value must be a timestamp in RFC3339 format",
+		func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+				return
+			}
+			if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					req.Path,
+					"Invalid timestamp",
+					fmt.Sprintf("Expected a timestamp in RFC3339 format, for example '2026-11-07T22:00:00Z', got '%s'",
+						req.ConfigValue.ValueString()),
+				)
+			}
+		})
+}
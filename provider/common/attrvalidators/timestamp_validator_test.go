@@ -0,0 +1,34 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package attrvalidators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RFC3339 timestamp validator", func() {
+	DescribeTable("should validate correctly",
+		func(value types.String, expectedErr bool) {
+			request := validator.StringRequest{
+				Path:           path.Root("upgrade_next_run"),
+				PathExpression: path.MatchRoot("upgrade_next_run"),
+				ConfigValue:    value,
+			}
+			response := validator.StringResponse{}
+			RFC3339TimestampValidator().ValidateString(context.Background(), request, &response)
+			Expect(response.Diagnostics.HasError()).To(Equal(expectedErr))
+		},
+		Entry("null value -> ok", types.StringNull(), false),
+		Entry("UTC timestamp -> ok", types.StringValue("2026-11-07T22:00:00Z"), false),
+		Entry("timestamp with offset -> ok", types.StringValue("2026-11-07T22:00:00+02:00"), false),
+		Entry("date only -> error", types.StringValue("2026-11-07"), true),
+		Entry("missing timezone -> error", types.StringValue("2026-11-07T22:00:00"), true),
+	)
+})
@@ -0,0 +1,198 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+)
+
+// DefaultClusterCacheTTL is the default time that a cluster fetched from OCM is reused.
+const DefaultClusterCacheTTL = 30 * time.Second
+
+const clustersPath = "/api/clusters_mgmt/v1/clusters/"
+
+// ClusterCache caches the clusters fetched from OCM for a short time, so that the many resources
+// that read the same cluster during a refresh don't send the same request over and over. It is
+// installed as a transport wrapper of the connection that is given to the resources, so it
+// applies to both ClusterClient.FetchCluster and direct 'Cluster(id).Get()' calls. Any write to a
+// cluster or to one of its sub-resources invalidates the cached cluster.
+type ClusterCache struct {
+	ttl     time.Duration
+	now     func() time.Time
+	lock    sync.Mutex
+	entries map[string]*clusterCacheEntry
+	loading map[string]chan struct{}
+	writes  map[string]uint64
+}
+
+type clusterCacheEntry struct {
+	expires time.Time
+	code    int
+	header  http.Header
+	body    []byte
+}
+
+type bypassClusterCacheKey struct{}
+
+// BypassClusterCache returns a context whose requests always fetch the cluster from OCM, and then
+// refresh the cached copy. It is meant for callers that poll the cluster waiting for it to change.
+func BypassClusterCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassClusterCacheKey{}, true)
+}
+
+// NewClusterCache creates a cache that keeps the clusters for the given time. A zero TTL disables
+// the cache.
+func NewClusterCache(ttl time.Duration) *ClusterCache {
+	return &ClusterCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[string]*clusterCacheEntry{},
+		loading: map[string]chan struct{}{},
+		writes:  map[string]uint64{},
+	}
+}
+
+// TransportWrapper returns the transport wrapper that installs the cache in a connection.
+func (c *ClusterCache) TransportWrapper() sdk.TransportWrapper {
+	return func(transport http.RoundTripper) http.RoundTripper {
+		if c.ttl <= 0 {
+			return transport
+		}
+		return &clusterCacheRoundTripper{cache: c, transport: transport}
+	}
+}
+
+// Invalidate drops the cached copy of the given cluster.
+func (c *ClusterCache) Invalidate(clusterId string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.entries, clusterId)
+	c.writes[clusterId]++
+}
+
+type clusterCacheRoundTripper struct {
+	cache     *ClusterCache
+	transport http.RoundTripper
+}
+
+func (t *clusterCacheRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	clusterId, isCluster := parseClusterPath(request.URL.Path)
+	if clusterId == "" {
+		return t.transport.RoundTrip(request)
+	}
+	if request.Method != http.MethodGet {
+		response, err := t.transport.RoundTrip(request)
+		t.cache.Invalidate(clusterId)
+		return response, err
+	}
+	if !isCluster || request.URL.RawQuery != "" {
+		return t.transport.RoundTrip(request)
+	}
+	if request.Context().Value(bypassClusterCacheKey{}) != nil {
+		t.cache.lock.Lock()
+		writes := t.cache.writes[clusterId]
+		t.cache.lock.Unlock()
+		return t.cache.fetch(request, clusterId, writes, t.transport)
+	}
+	return t.cache.get(request, clusterId, t.transport)
+}
+
+// get returns the cached cluster, or fetches it making sure that only one request for the same
+// cluster is in flight.
+func (c *ClusterCache) get(request *http.Request, clusterId string,
+	transport http.RoundTripper) (*http.Response, error) {
+	for {
+		c.lock.Lock()
+		if entry, ok := c.entries[clusterId]; ok && c.now().Before(entry.expires) {
+			c.lock.Unlock()
+			tflog.Debug(request.Context(), "Using cached cluster", map[string]any{"cluster": clusterId})
+			return entry.response(request), nil
+		}
+		loading, ok := c.loading[clusterId]
+		if !ok {
+			break
+		}
+		c.lock.Unlock()
+		select {
+		case <-loading:
+		case <-request.Context().Done():
+			return nil, request.Context().Err()
+		}
+	}
+	done := make(chan struct{})
+	c.loading[clusterId] = done
+	writes := c.writes[clusterId]
+	c.lock.Unlock()
+
+	defer func() {
+		c.lock.Lock()
+		delete(c.loading, clusterId)
+		c.lock.Unlock()
+		close(done)
+	}()
+
+	return c.fetch(request, clusterId, writes, transport)
+}
+
+// fetch sends the request and caches the cluster, unless it was changed since the given number of
+// writes was read.
+func (c *ClusterCache) fetch(request *http.Request, clusterId string, writes uint64,
+	transport http.RoundTripper) (*http.Response, error) {
+	response, err := transport.RoundTrip(request)
+	if err != nil || response.StatusCode != http.StatusOK {
+		return response, err
+	}
+	body, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	entry := &clusterCacheEntry{
+		expires: c.now().Add(c.ttl),
+		code:    response.StatusCode,
+		header:  response.Header.Clone(),
+		body:    body,
+	}
+
+	c.lock.Lock()
+	if c.writes[clusterId] == writes {
+		c.entries[clusterId] = entry
+	}
+	c.lock.Unlock()
+
+	return entry.response(request), nil
+}
+
+func (e *clusterCacheEntry) response(request *http.Request) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(e.code),
+		StatusCode:    e.code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       request,
+	}
+}
+
+// parseClusterPath returns the identifier of the cluster that the given path refers to, and if
+// the path is the cluster itself rather than one of its sub-resources.
+func parseClusterPath(path string) (string, bool) {
+	if !strings.HasPrefix(path, clustersPath) {
+		return "", false
+	}
+	clusterId, rest, _ := strings.Cut(strings.TrimPrefix(path, clustersPath), "/")
+	return clusterId, rest == ""
+}
@@ -0,0 +1,98 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+)
+
+type countingRoundTripper struct {
+	requests []string
+}
+
+func (c *countingRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	c.requests = append(c.requests, request.Method+" "+request.URL.Path)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"id": "123"}`)),
+	}, nil
+}
+
+var _ = Describe("ClusterCache", func() {
+	var (
+		now       time.Time
+		cache     *ClusterCache
+		server    *countingRoundTripper
+		transport http.RoundTripper
+	)
+
+	send := func(ctx context.Context, method string, path string) string {
+		request, err := http.NewRequestWithContext(ctx, method, "https://api.example.com"+path, nil)
+		Expect(err).NotTo(HaveOccurred())
+		response, err := transport.RoundTrip(request)
+		Expect(err).NotTo(HaveOccurred())
+		body, err := io.ReadAll(response.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return string(body)
+	}
+
+	BeforeEach(func() {
+		now = time.Now()
+		cache = NewClusterCache(30 * time.Second)
+		cache.now = func() time.Time { return now }
+		server = &countingRoundTripper{}
+		transport = cache.TransportWrapper()(server)
+	})
+
+	It("reuses the cluster until it expires", func() {
+		ctx := context.Background()
+		Expect(send(ctx, http.MethodGet, "/api/clusters_mgmt/v1/clusters/123")).To(Equal(`{"id": "123"}`))
+		Expect(send(ctx, http.MethodGet, "/api/clusters_mgmt/v1/clusters/123")).To(Equal(`{"id": "123"}`))
+		Expect(server.requests).To(HaveLen(1))
+
+		now = now.Add(time.Minute)
+		send(ctx, http.MethodGet, "/api/clusters_mgmt/v1/clusters/123")
+		Expect(server.requests).To(HaveLen(2))
+	})
+
+	It("discards the cluster when one of its sub-resources changes", func() {
+		ctx := context.Background()
+		send(ctx, http.MethodGet, "/api/clusters_mgmt/v1/clusters/123")
+		send(ctx, http.MethodPost, "/api/clusters_mgmt/v1/clusters/123/machine_pools")
+		send(ctx, http.MethodGet, "/api/clusters_mgmt/v1/clusters/123")
+		Expect(server.requests).To(Equal([]string{
+			"GET /api/clusters_mgmt/v1/clusters/123",
+			"POST /api/clusters_mgmt/v1/clusters/123/machine_pools",
+			"GET /api/clusters_mgmt/v1/clusters/123",
+		}))
+	})
+
+	It("doesn't cache sub-resources", func() {
+		ctx := context.Background()
+		send(ctx, http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/machine_pools")
+		send(ctx, http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/machine_pools")
+		Expect(server.requests).To(HaveLen(2))
+	})
+
+	It("refreshes the cluster when bypassed", func() {
+		send(context.Background(), http.MethodGet, "/api/clusters_mgmt/v1/clusters/123")
+		send(BypassClusterCache(context.Background()), http.MethodGet, "/api/clusters_mgmt/v1/clusters/123")
+		Expect(server.requests).To(HaveLen(2))
+	})
+
+	It("is disabled with a zero TTL", func() {
+		transport = NewClusterCache(0).TransportWrapper()(server)
+		send(context.Background(), http.MethodGet, "/api/clusters_mgmt/v1/clusters/123")
+		send(context.Background(), http.MethodGet, "/api/clusters_mgmt/v1/clusters/123")
+		Expect(server.requests).To(HaveLen(2))
+	})
+})
@@ -13,6 +13,7 @@ import (
 //go:generate mockgen -source=cluster_client.go -package=common -destination=mock_clusterclient.go
 type ClusterClient interface {
 	FetchCluster(ctx context.Context, clusterId string) (*cmv1.Cluster, error)
+	FetchNodePools(ctx context.Context, clusterId string) ([]*cmv1.NodePool, error)
 }
 
 type DefaultClusterClient struct {
@@ -32,3 +33,11 @@ func (c *DefaultClusterClient) FetchCluster(ctx context.Context, clusterId strin
 		return clusterResp.Body(), nil
 	}
 }
+
+func (c *DefaultClusterClient) FetchNodePools(ctx context.Context, clusterId string) ([]*cmv1.NodePool, error) {
+	nodePoolsResp, err := c.client.Cluster(clusterId).NodePools().List().Page(1).Size(-1).SendContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Can't list node pools of cluster '%s': %v", clusterId, err)
+	}
+	return nodePoolsResp.Items().Slice(), nil
+}
@@ -0,0 +1,126 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// installLogTailLines is the number of lines of the install logs attached to the diagnostics.
+const installLogTailLines = 50
+
+// ClusterInstallFailure is the error returned when waiting for a cluster that will never become
+// ready. It contains the details that OCM provides about the failure.
+type ClusterInstallFailure struct {
+	ClusterID             string
+	State                 cmv1.ClusterState
+	ProvisionErrorCode    string
+	ProvisionErrorMessage string
+	Description           string
+	FailedInflightChecks  []*cmv1.InflightCheck
+	InstallLogs           string
+}
+
+func (f *ClusterInstallFailure) Error() string {
+	message := fmt.Sprintf("Cluster '%s' is in state '%s' and will not become ready", f.ClusterID, f.State)
+	reason := f.reason()
+	if reason != "" {
+		message = fmt.Sprintf("%s: %s", message, reason)
+	}
+	return message
+}
+
+func (f *ClusterInstallFailure) reason() string {
+	switch {
+	case f.ProvisionErrorCode != "" && f.ProvisionErrorMessage != "":
+		return fmt.Sprintf("%s: %s", f.ProvisionErrorCode, f.ProvisionErrorMessage)
+	case f.ProvisionErrorMessage != "":
+		return f.ProvisionErrorMessage
+	case f.ProvisionErrorCode != "":
+		return f.ProvisionErrorCode
+	}
+	return f.Description
+}
+
+// Diagnostics returns a diagnostic for each failed inflight check and a warning with the tail
+// of the install logs.
+func (f *ClusterInstallFailure) Diagnostics() diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	for _, check := range f.FailedInflightChecks {
+		details := ""
+		if check.Details() != nil {
+			data, err := json.Marshal(check.Details())
+			if err == nil {
+				details = string(data)
+			}
+		}
+		diags.AddError(
+			fmt.Sprintf("Inflight check '%s' failed", check.Name()),
+			fmt.Sprintf("Inflight check '%s' of cluster '%s' failed with details: %s", check.Name(), f.ClusterID, details),
+		)
+	}
+	if f.InstallLogs != "" {
+		diags.AddWarning(
+			fmt.Sprintf("Install logs of cluster '%s'", f.ClusterID),
+			fmt.Sprintf("Last %d lines of the install logs:\n%s", installLogTailLines, f.InstallLogs),
+		)
+	}
+	return diags
+}
+
+// ClusterWaitDiagnostics returns the additional diagnostics of an error returned while waiting
+// for a cluster, or no diagnostics if the error doesn't carry install failure details.
+func ClusterWaitDiagnostics(err error) diag.Diagnostics {
+	var failure *ClusterInstallFailure
+	if errors.As(err, &failure) {
+		return failure.Diagnostics()
+	}
+	return nil
+}
+
+// newClusterInstallFailure collects the details of a cluster that will never become ready. The
+// inflight checks and the install logs are fetched on a best-effort basis: errors are logged and
+// otherwise ignored, so that the original failure is always reported.
+func newClusterInstallFailure(ctx context.Context, collection *cmv1.ClustersClient,
+	cluster *cmv1.Cluster) *ClusterInstallFailure {
+	failure := &ClusterInstallFailure{
+		ClusterID:             cluster.ID(),
+		State:                 cluster.State(),
+		ProvisionErrorCode:    cluster.Status().ProvisionErrorCode(),
+		ProvisionErrorMessage: cluster.Status().ProvisionErrorMessage(),
+		Description:           cluster.Status().Description(),
+	}
+	if cluster.State() != cmv1.ClusterStateError {
+		return failure
+	}
+	resource := collection.Cluster(cluster.ID())
+
+	checksResp, err := resource.InflightChecks().List().SendContext(ctx)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Can't list inflight checks of cluster '%s': %v", cluster.ID(), err))
+	} else {
+		checksResp.Items().Each(func(check *cmv1.InflightCheck) bool {
+			if check.State() == cmv1.InflightCheckStateFailed {
+				failure.FailedInflightChecks = append(failure.FailedInflightChecks, check)
+			}
+			return true
+		})
+	}
+
+	logsResp, err := resource.Logs().Install().Get().Tail(installLogTailLines).SendContext(ctx)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Can't get install logs of cluster '%s': %v", cluster.ID(), err))
+	} else {
+		failure.InstallLogs = strings.TrimSpace(logsResp.Body().Content())
+	}
+	return failure
+}
@@ -0,0 +1,68 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("ClusterInstallFailure", func() {
+	It("includes the provision error in the message", func() {
+		failure := &ClusterInstallFailure{
+			ClusterID:             "123",
+			State:                 cmv1.ClusterStateError,
+			ProvisionErrorCode:    "OCM3055",
+			ProvisionErrorMessage: "Subnets are missing",
+		}
+		Expect(failure.Error()).To(Equal(
+			"Cluster '123' is in state 'error' and will not become ready: OCM3055: Subnets are missing"))
+	})
+
+	It("falls back to the status description", func() {
+		failure := &ClusterInstallFailure{
+			ClusterID:   "123",
+			State:       cmv1.ClusterStateError,
+			Description: "Install failed",
+		}
+		Expect(failure.Error()).To(Equal("Cluster '123' is in state 'error' and will not become ready: Install failed"))
+	})
+
+	It("keeps the message short without details", func() {
+		failure := &ClusterInstallFailure{
+			ClusterID: "123",
+			State:     cmv1.ClusterStateUninstalling,
+		}
+		Expect(failure.Error()).To(Equal("Cluster '123' is in state 'uninstalling' and will not become ready"))
+	})
+
+	It("returns a diagnostic per failed inflight check and the install logs", func() {
+		check, err := cmv1.NewInflightCheck().
+			Name("egress").
+			State(cmv1.InflightCheckStateFailed).
+			Details(map[string]any{"subnet-123": "egress blocked"}).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		failure := &ClusterInstallFailure{
+			ClusterID:            "123",
+			State:                cmv1.ClusterStateError,
+			FailedInflightChecks: []*cmv1.InflightCheck{check},
+			InstallLogs:          "level=error msg=failed",
+		}
+
+		diags := ClusterWaitDiagnostics(fmt.Errorf("wrapped: %w", failure))
+		Expect(diags.ErrorsCount()).To(Equal(1))
+		Expect(diags.Errors()[0].Summary()).To(Equal("Inflight check 'egress' failed"))
+		Expect(diags.Errors()[0].Detail()).To(ContainSubstring(`{"subnet-123":"egress blocked"}`))
+		Expect(diags.WarningsCount()).To(Equal(1))
+		Expect(diags.Warnings()[0].Detail()).To(ContainSubstring("level=error msg=failed"))
+	})
+
+	It("returns no diagnostics for other errors", func() {
+		Expect(ClusterWaitDiagnostics(fmt.Errorf("timeout"))).To(BeEmpty())
+	})
+})
@@ -5,7 +5,9 @@ package common
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/rand"
 	"net/http"
 	"time"
 
@@ -14,7 +16,18 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 )
 
-const pollingIntervalInMinutes = 2
+const (
+	// DefaultClusterWaitPollingInterval is the default interval between two polls of the cluster.
+	DefaultClusterWaitPollingInterval = 2 * time.Minute
+
+	// DefaultClusterReadyTimeout is the default time that resources wait for their cluster to be
+	// ready before creating anything on it.
+	DefaultClusterReadyTimeout = 60 * time.Minute
+
+	defaultBackoffAttempts = 3
+	defaultBackoffBase     = 30 * time.Second
+	defaultBackoffMax      = 5 * time.Minute
+)
 
 //go:generate mockgen -source=cluster_waiter.go -package=common -destination=mock_clusterwait.go
 type ClusterWait interface {
@@ -23,15 +36,140 @@ type ClusterWait interface {
 }
 
 type DefaultClusterWait struct {
-	collection *cmv1.ClustersClient
-	connection *sdk.Connection
+	collection      *cmv1.ClustersClient
+	connection      *sdk.Connection
+	pollingInterval time.Duration
+	backoffAttempts int
+	backoffBase     time.Duration
+	backoffMax      time.Duration
+}
+
+// ClusterWaitOption customizes a cluster waiter created with NewClusterWait.
+type ClusterWaitOption func(*DefaultClusterWait)
+
+// WithPollingInterval sets the interval between two polls of the cluster. Non positive intervals
+// are ignored, so the default is used when the provider configuration doesn't set one.
+func WithPollingInterval(interval time.Duration) ClusterWaitOption {
+	return func(dw *DefaultClusterWait) {
+		if interval > 0 {
+			dw.pollingInterval = interval
+		}
+	}
+}
+
+// WithBackoff sets the number of attempts made when polling fails, and the base and maximum
+// delays of the exponential backoff between them.
+func WithBackoff(attempts int, base, max time.Duration) ClusterWaitOption {
+	return func(dw *DefaultClusterWait) {
+		dw.backoffAttempts = attempts
+		dw.backoffBase = base
+		dw.backoffMax = max
+	}
 }
 
-func NewClusterWait(collection *cmv1.ClustersClient, connection *sdk.Connection) ClusterWait {
-	return &DefaultClusterWait{
-		collection: collection,
-		connection: connection,
+func NewClusterWait(collection *cmv1.ClustersClient, connection *sdk.Connection, opts ...ClusterWaitOption) ClusterWait {
+	dw := &DefaultClusterWait{
+		collection:      collection,
+		connection:      connection,
+		pollingInterval: DefaultClusterWaitPollingInterval,
+		backoffAttempts: defaultBackoffAttempts,
+		backoffBase:     defaultBackoffBase,
+		backoffMax:      defaultBackoffMax,
 	}
+	for _, opt := range opts {
+		opt(dw)
+	}
+	return dw
+}
+
+// backoffDelay returns the delay before the given retry, starting at zero. The delay grows
+// exponentially up to the maximum, and a random jitter of up to half of it is applied so that
+// concurrent waiters don't retry at the same time.
+func (dw *DefaultClusterWait) backoffDelay(retry int) time.Duration {
+	delay := dw.backoffMax
+	if retry < 32 {
+		if exponential := dw.backoffBase << retry; exponential > 0 && exponential < dw.backoffMax {
+			delay = exponential
+		}
+	}
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// poll calls the given function until it succeeds, retrying with an exponential backoff. The
+// deadline of the wait is the one of the given context, so it isn't extended by the retries, and
+// errors caused by the context being done aren't retried.
+func (dw *DefaultClusterWait) poll(ctx context.Context, clusterId string,
+	pollFunc func() (*cmv1.Cluster, error)) (*cmv1.Cluster, error) {
+	for retry := 0; ; retry++ {
+		if dw.connection != nil {
+			tflog.Debug(ctx, fmt.Sprintf("Updating tokens for cluster %s", clusterId))
+			dw.connection.Tokens()
+		}
+		cluster, err := pollFunc()
+		if err == nil {
+			return cluster, nil
+		}
+		if ctx.Err() != nil || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, err
+		}
+		if retry+1 >= dw.backoffAttempts {
+			return nil, err
+		}
+		delay := dw.backoffDelay(retry)
+		tflog.Warn(ctx, fmt.Sprintf("Polling cluster '%s' failed, retrying in %s: %v", clusterId, delay, err))
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// logProgress reports the progress of a cluster that is being waited for.
+func logProgress(ctx context.Context, object *cmv1.Cluster) {
+	phase := installPhase(object)
+	tflog.Info(ctx, fmt.Sprintf("Cluster '%s' is in state '%s' (%s), %d/%d compute nodes are ready",
+		object.ID(), object.State(), phase, object.Status().CurrentCompute(), object.Nodes().Compute()),
+		map[string]any{
+			"state":          object.State(),
+			"installPhase":   phase,
+			"description":    object.Status().Description(),
+			"dnsReady":       object.Status().DNSReady(),
+			"oidcReady":      object.Status().OIDCReady(),
+			"currentCompute": object.Status().CurrentCompute(),
+			"desiredCompute": object.Nodes().Compute(),
+		})
+}
+
+// installPhase describes how far the installation of the cluster went. The API doesn't report the
+// phase directly, so it is derived from the state and the readiness flags of the status.
+func installPhase(object *cmv1.Cluster) string {
+	status := object.Status()
+	switch object.State() {
+	case cmv1.ClusterStateValidating:
+		return "validating the configuration"
+	case cmv1.ClusterStateWaiting:
+		return "waiting for the operator roles and the OIDC provider"
+	case cmv1.ClusterStatePending:
+		return "preparing the installation"
+	case cmv1.ClusterStateInstalling:
+		if !status.DNSReady() {
+			return "installing, DNS not ready yet"
+		}
+		return "installing, DNS ready"
+	case cmv1.ClusterStateReady:
+		return "installed"
+	case cmv1.ClusterStateError:
+		if code := status.ProvisionErrorCode(); code != "" {
+			return fmt.Sprintf("failed with code '%s'", code)
+		}
+		return "failed"
+	}
+	return "not installing"
 }
 
 func (dw *DefaultClusterWait) WaitForStdComputeNodesToBeReady(ctx context.Context, clusterId string, waitTimeoutMin int64) (*cmv1.Cluster, error) {
@@ -53,20 +191,13 @@ func (dw *DefaultClusterWait) WaitForStdComputeNodesToBeReady(ctx context.Contex
 		return resp.Body(), nil
 	}
 
-	backoffAttempts := 3
-	backoffSleep := 30 * time.Second
-	var cluster *cmv1.Cluster
-	for cluster == nil {
-		tflog.Debug(ctx, fmt.Sprintf("Updating tokens for cluster %s", clusterId))
-		dw.connection.Tokens()
-		cluster, err = pollClusterCurrentCompute(clusterId, ctx, waitTimeoutMin, dw.collection)
-		if err != nil {
-			backoffAttempts--
-			if backoffAttempts == 0 {
-				return nil, fmt.Errorf("polling cluster state failed with error %v", err)
-			}
-			time.Sleep(backoffSleep)
-		}
+	waitCtx, cancel := context.WithTimeout(ctx, time.Duration(waitTimeoutMin)*time.Minute)
+	defer cancel()
+	cluster, err := dw.poll(waitCtx, clusterId, func() (*cmv1.Cluster, error) {
+		return dw.pollClusterCurrentCompute(waitCtx, clusterId)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("polling cluster state failed with error %v", err)
 	}
 	tflog.Info(ctx, fmt.Sprintf("WaitForStdComputeNodesToBeReady: Wait done for cluster '%s' with %d/%d", clusterId,
 		cluster.Nodes().Compute(), cluster.Status().CurrentCompute()))
@@ -92,9 +223,9 @@ func (dw *DefaultClusterWait) WaitForClusterToBeReady(ctx context.Context, clust
 	}
 	currentState := resp.Body().State()
 	if currentState == cmv1.ClusterStateError || currentState == cmv1.ClusterStateUninstalling {
-		message := fmt.Sprintf("Cluster '%s' is in state '%s' and will not become ready", clusterId, currentState)
-		tflog.Error(ctx, message)
-		return resp.Body(), fmt.Errorf("%s", message)
+		failure := newClusterInstallFailure(ctx, dw.collection, resp.Body())
+		tflog.Error(ctx, failure.Error())
+		return resp.Body(), failure
 	}
 	if currentState == cmv1.ClusterStateReady {
 		tflog.Info(ctx, fmt.Sprintf("WaitForClusterToBeReady: Cluster '%s' is with state \"READY\"", clusterId))
@@ -104,20 +235,13 @@ func (dw *DefaultClusterWait) WaitForClusterToBeReady(ctx context.Context, clust
 	tflog.Info(ctx, fmt.Sprintf("WaitForClusterToBeReady: Cluster '%s' is with state '%s', Wait for the state to become 'READY' with timeout %d minutes",
 		clusterId, currentState, waitTimeoutMin))
 
-	backoffAttempts := 3
-	backoffSleep := 30 * time.Second
-	var cluster *cmv1.Cluster
-	for cluster == nil {
-		tflog.Debug(ctx, fmt.Sprintf("Updating tokens for cluster %s", clusterId))
-		dw.connection.Tokens()
-		cluster, err = pollClusterState(clusterId, ctx, waitTimeoutMin, dw.collection)
-		if err != nil {
-			backoffAttempts--
-			if backoffAttempts == 0 {
-				return nil, fmt.Errorf("polling cluster state failed with error %v", err)
-			}
-			time.Sleep(backoffSleep)
-		}
+	waitCtx, cancel := context.WithTimeout(ctx, time.Duration(waitTimeoutMin)*time.Minute)
+	defer cancel()
+	cluster, err := dw.poll(waitCtx, clusterId, func() (*cmv1.Cluster, error) {
+		return dw.pollClusterState(waitCtx, clusterId)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("polling cluster state failed with error %v", err)
 	}
 
 	tflog.Info(ctx, fmt.Sprintf("WaitForClusterToBeReady: Wait done for cluster '%s' with state '%s'", clusterId, currentState))
@@ -127,28 +251,26 @@ func (dw *DefaultClusterWait) WaitForClusterToBeReady(ctx context.Context, clust
 	if cluster.State() == cmv1.ClusterStateReady {
 		return cluster, nil
 	}
-	return cluster, fmt.Errorf("cluster '%s' is in state '%s'", clusterId, cluster.State())
+	failure := newClusterInstallFailure(ctx, dw.collection, cluster)
+	tflog.Error(ctx, failure.Error())
+	return cluster, failure
 }
 
-func pollClusterCurrentCompute(clusterId string, ctx context.Context, timeout int64, clusterCollection *cmv1.ClustersClient) (*cmv1.Cluster, error) {
-	client := clusterCollection.Cluster(clusterId)
+func (dw *DefaultClusterWait) pollClusterCurrentCompute(ctx context.Context, clusterId string) (*cmv1.Cluster, error) {
+	client := dw.collection.Cluster(clusterId)
 	var object *cmv1.Cluster
-	pollCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Minute)
-	defer cancel()
 	_, err := client.Poll().
-		Interval(pollingIntervalInMinutes * time.Minute).
+		Interval(dw.pollingInterval).
 		Predicate(func(getClusterResponse *cmv1.ClusterGetResponse) bool {
 			object = getClusterResponse.Body()
-			tflog.Debug(ctx, "polled cluster compute", map[string]any{
-				"currentCompute": object.Status().CurrentCompute(),
-			})
+			logProgress(ctx, object)
 			switch object.Status().CurrentCompute() {
 			case object.Nodes().Compute():
 				return true
 			}
 			return false
 		}).
-		StartContext(pollCtx)
+		StartContext(BypassClusterCache(ctx))
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("Failed polling cluster compute: %v", err))
 		return nil, err
@@ -157,18 +279,14 @@ func pollClusterCurrentCompute(clusterId string, ctx context.Context, timeout in
 	return object, nil
 }
 
-func pollClusterState(clusterId string, ctx context.Context, timeout int64, clusterCollection *cmv1.ClustersClient) (*cmv1.Cluster, error) {
-	client := clusterCollection.Cluster(clusterId)
+func (dw *DefaultClusterWait) pollClusterState(ctx context.Context, clusterId string) (*cmv1.Cluster, error) {
+	client := dw.collection.Cluster(clusterId)
 	var object *cmv1.Cluster
-	pollCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Minute)
-	defer cancel()
 	_, err := client.Poll().
-		Interval(pollingIntervalInMinutes * time.Minute).
+		Interval(dw.pollingInterval).
 		Predicate(func(getClusterResponse *cmv1.ClusterGetResponse) bool {
 			object = getClusterResponse.Body()
-			tflog.Debug(ctx, "polled cluster state", map[string]any{
-				"state": object.State(),
-			})
+			logProgress(ctx, object)
 			switch object.State() {
 			case cmv1.ClusterStateReady,
 				cmv1.ClusterStateError,
@@ -177,7 +295,7 @@ func pollClusterState(clusterId string, ctx context.Context, timeout int64, clus
 			}
 			return false
 		}).
-		StartContext(pollCtx)
+		StartContext(BypassClusterCache(ctx))
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("Failed polling cluster state: %v", err))
 		return nil, err
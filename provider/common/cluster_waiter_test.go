@@ -0,0 +1,80 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Cluster waiter", func() {
+	DescribeTable("installPhase",
+		func(state cmv1.ClusterState, status *cmv1.ClusterStatusBuilder, expected string) {
+			object, err := cmv1.NewCluster().ID("123").State(state).Status(status).Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(installPhase(object)).To(Equal(expected))
+		},
+		Entry("waiting", cmv1.ClusterStateWaiting, cmv1.NewClusterStatus(),
+			"waiting for the operator roles and the OIDC provider"),
+		Entry("installing without DNS", cmv1.ClusterStateInstalling, cmv1.NewClusterStatus(),
+			"installing, DNS not ready yet"),
+		Entry("installing with DNS", cmv1.ClusterStateInstalling, cmv1.NewClusterStatus().DNSReady(true),
+			"installing, DNS ready"),
+		Entry("failed", cmv1.ClusterStateError, cmv1.NewClusterStatus().ProvisionErrorCode("OCM3055"),
+			"failed with code 'OCM3055'"),
+		Entry("ready", cmv1.ClusterStateReady, cmv1.NewClusterStatus(), "installed"),
+		Entry("uninstalling", cmv1.ClusterStateUninstalling, cmv1.NewClusterStatus(), "not installing"),
+	)
+
+	It("ignores non positive polling intervals", func() {
+		wait := NewClusterWait(nil, nil, WithPollingInterval(0)).(*DefaultClusterWait)
+		Expect(wait.pollingInterval).To(Equal(DefaultClusterWaitPollingInterval))
+		wait = NewClusterWait(nil, nil, WithPollingInterval(DefaultClusterWaitPollingInterval/2)).(*DefaultClusterWait)
+		Expect(wait.pollingInterval).To(Equal(DefaultClusterWaitPollingInterval / 2))
+	})
+
+	Describe("poll", func() {
+		newWait := func() *DefaultClusterWait {
+			return NewClusterWait(nil, nil, WithBackoff(3, time.Millisecond, time.Millisecond)).(*DefaultClusterWait)
+		}
+
+		It("retries failed polls", func() {
+			calls := 0
+			_, err := newWait().poll(context.Background(), "123", func() (*cmv1.Cluster, error) {
+				calls++
+				return nil, errors.New("connection reset")
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(calls).To(Equal(3))
+		})
+
+		It("doesn't retry when the wait times out", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+			defer cancel()
+			calls := 0
+			_, err := newWait().poll(ctx, "123", func() (*cmv1.Cluster, error) {
+				calls++
+				<-ctx.Done()
+				return nil, ctx.Err()
+			})
+			Expect(err).To(MatchError(context.DeadlineExceeded))
+			Expect(calls).To(Equal(1))
+		})
+
+		It("doesn't retry when the wait is canceled", func() {
+			calls := 0
+			_, err := newWait().poll(context.Background(), "123", func() (*cmv1.Cluster, error) {
+				calls++
+				return nil, context.Canceled
+			})
+			Expect(err).To(MatchError(context.Canceled))
+			Expect(calls).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,43 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"maps"
+)
+
+// MergeDefaults returns a new map with the given defaults and values, where the values take
+// precedence over the defaults.
+func MergeDefaults(defaults, values map[string]string) map[string]string {
+	result := make(map[string]string, len(defaults)+len(values))
+	maps.Copy(result, defaults)
+	maps.Copy(result, values)
+	return result
+}
+
+// DefaultsMissingFrom checks if any of the given defaults is missing from the given values, or
+// has a different value.
+func DefaultsMissingFrom(defaults, values map[string]string) bool {
+	for k, v := range defaults {
+		if current, ok := values[k]; !ok || current != v {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterDefaults removes from the given values the defaults that the user didn't set explicitly,
+// so that they don't show up as drift in attributes that only contain the values of the user.
+func FilterDefaults(values, defaults, explicit map[string]string) map[string]string {
+	result := maps.Clone(values)
+	for k, v := range defaults {
+		if _, ok := explicit[k]; ok {
+			continue
+		}
+		if current, ok := result[k]; ok && current == v {
+			delete(result, k)
+		}
+	}
+	return result
+}
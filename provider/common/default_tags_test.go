@@ -0,0 +1,34 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+)
+
+var _ = Describe("Default tags and properties", func() {
+	defaults := map[string]string{"owner": "team", "cost-center": "123"}
+
+	It("gives precedence to the values over the defaults", func() {
+		Expect(MergeDefaults(defaults, map[string]string{"owner": "me"})).To(Equal(map[string]string{
+			"owner":       "me",
+			"cost-center": "123",
+		}))
+	})
+
+	It("detects missing or changed defaults", func() {
+		Expect(DefaultsMissingFrom(defaults, map[string]string{"owner": "team", "cost-center": "123"})).To(BeFalse())
+		Expect(DefaultsMissingFrom(defaults, map[string]string{"owner": "team"})).To(BeTrue())
+		Expect(DefaultsMissingFrom(defaults, map[string]string{"owner": "me", "cost-center": "123"})).To(BeTrue())
+	})
+
+	It("filters out the defaults that weren't set explicitly", func() {
+		values := map[string]string{"owner": "team", "cost-center": "123", "app": "web"}
+		Expect(FilterDefaults(values, defaults, map[string]string{"owner": "team"})).To(Equal(map[string]string{
+			"owner": "team",
+			"app":   "web",
+		}))
+	})
+})
@@ -0,0 +1,85 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"strings"
+
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	"github.com/openshift-online/ocm-sdk-go/authentication"
+)
+
+const (
+	fedRAMPStageTokenURL = "https://sso.stage.openshiftusgov.com/realms/redhat-external/protocol/openid-connect/token"
+	fedRAMPIntTokenURL   = "https://sso.int.openshiftusgov.com/realms/redhat-external/protocol/openid-connect/token"
+)
+
+// Environment contains the API gateway, SSO server and OpenID client that go together in one OCM
+// environment.
+type Environment struct {
+	Name     string
+	URL      string
+	TokenURL string
+	ClientID string
+}
+
+// Environments lists the OCM environments that can be selected with the 'environment' attribute
+// of the provider.
+var Environments = []Environment{
+	{
+		Name:     "production",
+		URL:      sdk.DefaultURL,
+		TokenURL: sdk.DefaultTokenURL,
+		ClientID: sdk.DefaultClientID,
+	},
+	{
+		Name:     "stage",
+		URL:      "https://api.stage.openshift.com",
+		TokenURL: sdk.DefaultTokenURL,
+		ClientID: sdk.DefaultClientID,
+	},
+	{
+		Name:     "integration",
+		URL:      "https://api.integration.openshift.com",
+		TokenURL: sdk.DefaultTokenURL,
+		ClientID: sdk.DefaultClientID,
+	},
+	{
+		Name:     "fedramp-production",
+		URL:      sdk.FedRAMPURL,
+		TokenURL: authentication.FedRAMPTokenURL,
+		ClientID: authentication.FedRAMPClientID,
+	},
+	{
+		Name:     "fedramp-stage",
+		URL:      "https://api.stage.openshiftusgov.com",
+		TokenURL: fedRAMPStageTokenURL,
+		ClientID: authentication.FedRAMPClientID,
+	},
+	{
+		Name:     "fedramp-int",
+		URL:      "https://api.int.openshiftusgov.com",
+		TokenURL: fedRAMPIntTokenURL,
+		ClientID: authentication.FedRAMPClientID,
+	},
+}
+
+// EnvironmentNames returns the names of the environments, in the order they are listed.
+func EnvironmentNames() []string {
+	names := make([]string, len(Environments))
+	for i, environment := range Environments {
+		names[i] = environment.Name
+	}
+	return names
+}
+
+// LookupEnvironment returns the environment with the given name, ignoring case.
+func LookupEnvironment(name string) (Environment, bool) {
+	for _, environment := range Environments {
+		if strings.EqualFold(environment.Name, name) {
+			return environment, true
+		}
+	}
+	return Environment{}, false
+}
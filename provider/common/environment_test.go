@@ -0,0 +1,31 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+)
+
+var _ = Describe("Environments", func() {
+	It("resolves an environment ignoring case", func() {
+		environment, ok := LookupEnvironment("Stage")
+		Expect(ok).To(BeTrue())
+		Expect(environment.URL).To(Equal("https://api.stage.openshift.com"))
+		Expect(environment.ClientID).To(Equal("cloud-services"))
+	})
+
+	It("uses the GovCloud SSO server for FedRAMP environments", func() {
+		environment, ok := LookupEnvironment("fedramp-production")
+		Expect(ok).To(BeTrue())
+		Expect(environment.URL).To(Equal("https://api.openshiftusgov.com"))
+		Expect(environment.TokenURL).To(HavePrefix("https://sso.openshiftusgov.com/"))
+		Expect(environment.ClientID).To(Equal("console-dot"))
+	})
+
+	It("rejects unknown environments", func() {
+		_, ok := LookupEnvironment("staging")
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -36,6 +36,8 @@ const (
 	AssertionErrorSummaryMessage          = "Attribute value cannot be changed"
 	AssertionErrorDetailsMessage          = "Attribute %s, cannot be changed from %v to %v"
 	ValueCannotBeChangedStringDescription = "After the creation of the resource, it is not possible to update the attribute value."
+	MaxIAMRoleNameLength                  = 64
+	oidcEndpointURLScheme                 = "https://"
 )
 
 // shouldPatchInt changed checks if the change between the given state and plan requires sending a
@@ -126,15 +128,41 @@ func IsStringAttributeKnownAndEmpty(param types.String) bool {
 }
 
 func IsGreaterThanOrEqual(version1, version2 string) (bool, error) {
-	v1, err := version.NewVersion(strings.TrimPrefix(version1, versionPrefix))
+	result, err := CompareVersions(version1, version2)
 	if err != nil {
 		return false, err
 	}
+	return result >= 0, nil
+}
+
+// CompareVersions compares two OpenShift versions, with or without the "openshift-v" prefix. The
+// result is -1, 0 or 1 when the first version is lower than, equal to or greater than the second.
+func CompareVersions(version1, version2 string) (int, error) {
+	v1, err := version.NewVersion(strings.TrimPrefix(version1, versionPrefix))
+	if err != nil {
+		return 0, err
+	}
 	v2, err := version.NewVersion(strings.TrimPrefix(version2, versionPrefix))
 	if err != nil {
-		return false, err
+		return 0, err
 	}
-	return v1.GreaterThanOrEqual(v2), nil
+	return v1.Compare(v2), nil
+}
+
+// OperatorRoleName returns the name of the IAM role of a cluster operator, truncated to the
+// maximum length of an IAM role name.
+func OperatorRoleName(prefix, namespace, name string) string {
+	role := fmt.Sprintf("%s-%s-%s", prefix, namespace, name)
+	if len(role) > MaxIAMRoleNameLength {
+		role = role[0:MaxIAMRoleNameLength]
+	}
+	return role
+}
+
+// OIDCEndpointURL returns the OIDC endpoint URL used in the AWS IAM configuration, that is the
+// issuer URL without its scheme.
+func OIDCEndpointURL(issuerURL string) string {
+	return strings.TrimPrefix(issuerURL, oidcEndpointURLScheme)
 }
 
 func HandleErr(res *ocmerrors.Error, err error) error {
@@ -142,6 +170,10 @@ func HandleErr(res *ocmerrors.Error, err error) error {
 	if msg == "" {
 		msg = err.Error()
 	}
+	// Keep the operation identifier, as it is what the OCM team needs to track down a failure:
+	if operationID := res.OperationID(); operationID != "" && !strings.Contains(msg, operationID) {
+		msg = fmt.Sprintf("%s (operation ID: %s)", msg, operationID)
+	}
 	errType := weberr.ErrorType(res.Status())
 	return errType.Set(errors.Errorf("%s", msg))
 }
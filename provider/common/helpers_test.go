@@ -224,6 +224,20 @@ var _ = Describe("Helper function tests", func() {
 			result := HandleErr(ocmErr, errors.New("underlying transport error"))
 			Expect(result.Error()).To(ContainSubstring("underlying transport error"))
 		})
+
+		It("keeps the OCM operation ID", func() {
+			ocmErr, err := ocmerrors.UnmarshalErrorStatus(`{
+				"kind": "Error",
+				"id": "429",
+				"code": "CLUSTERS-MGMT-429",
+				"reason": "too many requests",
+				"operation_id": "8f3a7c2e"
+			}`, 429)
+			Expect(err).NotTo(HaveOccurred())
+
+			result := HandleErr(ocmErr, errors.New("fallback message"))
+			Expect(result.Error()).To(Equal("too many requests (operation ID: 8f3a7c2e)"))
+		})
 	})
 
 	DescribeTable("ValidateStateAndPlanEquals",
@@ -0,0 +1,72 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// ActiveLimitedSupportReasons returns the limited support reasons of a cluster, skipping the ones
+// that have been overridden.
+func ActiveLimitedSupportReasons(ctx context.Context,
+	client *cmv1.LimitedSupportReasonsClient) ([]*cmv1.LimitedSupportReason, error) {
+	reasons := []*cmv1.LimitedSupportReason{}
+	page := 1
+	size := 100
+	for {
+		resp, err := client.List().
+			Page(page).
+			Size(size).
+			SendContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list limited support reasons: %v", err)
+		}
+		resp.Items().Each(func(reason *cmv1.LimitedSupportReason) bool {
+			if !reason.Override().Enabled() {
+				reasons = append(reasons, reason)
+			}
+			return true
+		})
+		if resp.Size() < size {
+			break
+		}
+		page++
+	}
+	return reasons, nil
+}
+
+// LimitedSupportWarnings returns a warning when the cluster is in limited support, with the
+// summaries of the reasons. The reasons are only fetched when the cluster reports that it has
+// some, so that clusters with full support don't need an additional request.
+func LimitedSupportWarnings(ctx context.Context, collection *cmv1.ClustersClient,
+	object *cmv1.Cluster) diag.Diagnostics {
+	var diags diag.Diagnostics
+	count := object.Status().LimitedSupportReasonCount()
+	if count == 0 {
+		return diags
+	}
+	summary := fmt.Sprintf("Cluster '%s' is in limited support", object.ID())
+	reasons, err := ActiveLimitedSupportReasons(ctx, collection.Cluster(object.ID()).LimitedSupportReasons())
+	if err != nil {
+		diags.AddWarning(summary, fmt.Sprintf(
+			"The cluster has %d limited support reasons, but they can't be listed: %v", count, err))
+		return diags
+	}
+	if len(reasons) == 0 {
+		return diags
+	}
+	lines := make([]string, len(reasons))
+	for i, reason := range reasons {
+		lines[i] = fmt.Sprintf("- %s", reason.Summary())
+	}
+	diags.AddWarning(summary, fmt.Sprintf(
+		"The cluster has the following limited support reasons, the 'rhcs_cluster_limited_support_reasons' "+
+			"data source shows their details:\n%s", strings.Join(lines, "\n")))
+	return diags
+}
@@ -55,3 +55,18 @@ func (mr *MockClusterClientMockRecorder) FetchCluster(ctx, clusterId any) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchCluster", reflect.TypeOf((*MockClusterClient)(nil).FetchCluster), ctx, clusterId)
 }
+
+// FetchNodePools mocks base method.
+func (m *MockClusterClient) FetchNodePools(ctx context.Context, clusterId string) ([]*v1.NodePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchNodePools", ctx, clusterId)
+	ret0, _ := ret[0].([]*v1.NodePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchNodePools indicates an expected call of FetchNodePools.
+func (mr *MockClusterClientMockRecorder) FetchNodePools(ctx, clusterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchNodePools", reflect.TypeOf((*MockClusterClient)(nil).FetchNodePools), ctx, clusterId)
+}
@@ -0,0 +1,94 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+const (
+	// DefaultNetworkVerificationTimeout is the default time that the network verification resource
+	// waits for the verification of its subnets.
+	DefaultNetworkVerificationTimeout = 30 * time.Minute
+
+	NetworkVerificationStatePassed = "passed"
+	NetworkVerificationStateFailed = "failed"
+)
+
+// WaitForNetworkVerification polls the network verification of each of the given subnets every
+// pollingInterval until all of them either passed or failed, and returns the results in the same order. It fails when
+// the timeout expires.
+func WaitForNetworkVerification(ctx context.Context, client *cmv1.NetworkVerificationsClient, subnetIds []string,
+	timeout, pollingInterval time.Duration) ([]*cmv1.SubnetNetworkVerification, error) {
+	return waitForNetworkVerification(ctx, subnetIds, timeout, pollingInterval,
+		func(ctx context.Context, subnetId string) (*cmv1.SubnetNetworkVerification, error) {
+			getResp, err := client.NetworkVerification(subnetId).Get().SendContext(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return getResp.Body(), nil
+		})
+}
+
+// waitForNetworkVerification contains the logic of WaitForNetworkVerification.
+func waitForNetworkVerification(ctx context.Context, subnetIds []string, timeout, pollingInterval time.Duration,
+	poll func(ctx context.Context, subnetId string) (*cmv1.SubnetNetworkVerification, error),
+) ([]*cmv1.SubnetNetworkVerification, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	results := make([]*cmv1.SubnetNetworkVerification, len(subnetIds))
+	failures := 0
+	for {
+		pending := []string{}
+		for i, subnetId := range subnetIds {
+			if isNetworkVerificationComplete(results[i]) {
+				continue
+			}
+			result, err := poll(ctx, subnetId)
+			if err != nil {
+				pending = append(pending, subnetId)
+				if ctx.Err() != nil {
+					continue
+				}
+				failures++
+				if failures >= defaultBackoffAttempts {
+					return nil, fmt.Errorf("failed to get the network verification of subnet '%s': %v",
+						subnetId, err)
+				}
+				tflog.Warn(ctx, fmt.Sprintf("Getting the network verification of subnet '%s' failed, "+
+					"retrying: %v", subnetId, err))
+				continue
+			}
+			failures = 0
+			results[i] = result
+			if !isNetworkVerificationComplete(result) {
+				pending = append(pending, fmt.Sprintf("%s (%s)", subnetId, result.State()))
+			}
+		}
+		if len(pending) == 0 {
+			return results, nil
+		}
+		tflog.Info(ctx, fmt.Sprintf("Waiting for the network verification of subnets %v", pending))
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("network verification didn't complete within %s, the subnets that "+
+				"are still being verified are %v", timeout, pending)
+		case <-time.After(pollingInterval):
+		}
+	}
+}
+
+func isNetworkVerificationComplete(result *cmv1.SubnetNetworkVerification) bool {
+	if result == nil {
+		return false
+	}
+	return result.State() == NetworkVerificationStatePassed || result.State() == NetworkVerificationStateFailed
+}
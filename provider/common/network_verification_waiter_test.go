@@ -0,0 +1,74 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Network verification waiter", func() {
+	verification := func(subnetId, state string, details ...string) *cmv1.SubnetNetworkVerification {
+		object, err := cmv1.NewSubnetNetworkVerification().ID(subnetId).State(state).Details(details...).Build()
+		Expect(err).NotTo(HaveOccurred())
+		return object
+	}
+
+	// sequences returns a poll function that returns the given results of each subnet in order,
+	// repeating the last one. A nil result means that the poll fails.
+	sequences := func(results map[string][]*cmv1.SubnetNetworkVerification) func(context.Context, string) (
+		*cmv1.SubnetNetworkVerification, error) {
+		calls := map[string]int{}
+		return func(_ context.Context, subnetId string) (*cmv1.SubnetNetworkVerification, error) {
+			subnetResults := results[subnetId]
+			result := subnetResults[min(calls[subnetId], len(subnetResults)-1)]
+			calls[subnetId]++
+			if result == nil {
+				return nil, errors.New("boom")
+			}
+			return result, nil
+		}
+	}
+
+	It("waits until all the subnets are verified", func() {
+		results, err := waitForNetworkVerification(context.Background(), []string{"subnet-1", "subnet-2"},
+			time.Minute, time.Millisecond, sequences(map[string][]*cmv1.SubnetNetworkVerification{
+				"subnet-1": {
+					verification("subnet-1", "pending"),
+					nil,
+					verification("subnet-1", "passed"),
+				},
+				"subnet-2": {
+					verification("subnet-2", "running"),
+					verification("subnet-2", "failed", "egress to quay.io:443 blocked"),
+				},
+			}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(HaveLen(2))
+		Expect(results[0].State()).To(Equal("passed"))
+		Expect(results[1].State()).To(Equal("failed"))
+		Expect(results[1].Details()).To(ConsistOf("egress to quay.io:443 blocked"))
+	})
+
+	It("fails when polling keeps failing", func() {
+		_, err := waitForNetworkVerification(context.Background(), []string{"subnet-1"}, time.Minute, time.Millisecond,
+			sequences(map[string][]*cmv1.SubnetNetworkVerification{
+				"subnet-1": {nil},
+			}))
+		Expect(err).To(MatchError(ContainSubstring("failed to get the network verification of subnet 'subnet-1'")))
+	})
+
+	It("fails when the timeout expires", func() {
+		_, err := waitForNetworkVerification(context.Background(), []string{"subnet-1"}, 50*time.Millisecond, time.Millisecond,
+			sequences(map[string][]*cmv1.SubnetNetworkVerification{
+				"subnet-1": {verification("subnet-1", "running")},
+			}))
+		Expect(err).To(MatchError(ContainSubstring("network verification didn't complete within 50ms")))
+	})
+})
@@ -0,0 +1,40 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package planmodifiers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+// ApplyDefaultProperties plans the computed attribute that contains all the properties of an
+// existing cluster. When some of the given default properties of the provider are missing from it,
+// or have a different value, the attribute is marked as unknown, so that the plan shows the change
+// and the cluster is updated.
+//
+// Like MergeDefaultTags, it is meant to be called from the ModifyPlan method of the resource.
+func ApplyDefaultProperties(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+	ocmPropertiesPath path.Path, defaults map[string]string) {
+	// Creation and destroy don't need anything special.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var stateValue types.Map
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, ocmPropertiesPath, &stateValue)...)
+	if resp.Diagnostics.HasError() || !common.HasValue(stateValue) {
+		return
+	}
+	current, err := common.OptionalMap(ctx, stateValue)
+	if err != nil {
+		return
+	}
+	if common.DefaultsMissingFrom(defaults, current) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, ocmPropertiesPath, types.MapUnknown(types.StringType))...)
+	}
+}
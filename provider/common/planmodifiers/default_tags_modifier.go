@@ -0,0 +1,87 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package planmodifiers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+// MergeDefaultTags plans the computed attribute that contains the tags of the given tags attribute
+// merged with the given default tags of the provider. AWS tags can't be changed once the resource
+// exists, so the merged tags are only calculated on creation and kept from then on.
+//
+// It is meant to be called from the ModifyPlan method of the resource, because the defaults belong
+// to the configuration of the provider that manages the resource, and attribute plan modifiers
+// don't have access to it.
+func MergeDefaultTags(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+	tagsPath, tagsAllPath path.Path, defaults map[string]string) {
+	// Nothing to do on resource destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Nested attributes can only be planned when the object that contains them is set:
+	if parentPath := tagsAllPath.ParentPath(); !parentPath.Equal(path.Empty()) {
+		var parent types.Object
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, parentPath, &parent)...)
+		if resp.Diagnostics.HasError() || !common.HasValue(parent) {
+			return
+		}
+	}
+
+	// Keep the tags of existing resources, and let the user know if the defaults changed.
+	if !req.State.Raw.IsNull() {
+		var stateValue types.Map
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, tagsAllPath, &stateValue)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, tagsAllPath, stateValue)...)
+		current, err := common.OptionalMap(ctx, stateValue)
+		if err != nil || !common.HasValue(stateValue) {
+			return
+		}
+		if common.DefaultsMissingFrom(defaults, current) {
+			resp.Diagnostics.AddAttributeWarning(
+				tagsAllPath,
+				"Default tags not applied",
+				"The default tags of the provider changed, but the AWS tags of an existing resource can't be "+
+					"changed. The new default tags will only be applied to new resources.",
+			)
+		}
+		return
+	}
+
+	var tags types.Map
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, tagsPath, &tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if tags.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, tagsAllPath, types.MapUnknown(types.StringType))...)
+		return
+	}
+	values, err := common.OptionalMap(ctx, tags)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(tagsAllPath, "Invalid tags", err.Error())
+		return
+	}
+	merged := common.MergeDefaults(defaults, values)
+	if len(merged) == 0 {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, tagsAllPath, types.MapNull(types.StringType))...)
+		return
+	}
+	planValue, err := common.ConvertStringMapToMapType(merged)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(tagsAllPath, "Invalid tags", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, tagsAllPath, planValue)...)
+}
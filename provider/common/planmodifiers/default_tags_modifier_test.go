@@ -0,0 +1,180 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package planmodifiers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+var _ = Describe("Merge Default Tags Modifier", func() {
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"tags_all": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+
+	mapValue := func(values map[string]string) types.Map {
+		if values == nil {
+			return types.MapNull(types.StringType)
+		}
+		value, err := common.ConvertStringMapToMapType(values)
+		Expect(err).NotTo(HaveOccurred())
+		return value
+	}
+
+	raw := func(tags, tagsAll types.Map) tftypes.Value {
+		tagsValue, err := tags.ToTerraformValue(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		tagsAllValue, err := tagsAll.ToTerraformValue(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		return tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			map[string]tftypes.Value{
+				"tags":     tagsValue,
+				"tags_all": tagsAllValue,
+			},
+		)
+	}
+
+	nullState := tfsdk.State{
+		Schema: testSchema,
+		Raw:    tftypes.NewValue(testSchema.Type().TerraformType(context.Background()), nil),
+	}
+
+	defaults := map[string]string{"cost-center": "123", "owner": "team"}
+
+	run := func(req resource.ModifyPlanRequest, defaults map[string]string) (types.Map, *resource.ModifyPlanResponse) {
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		MergeDefaultTags(context.Background(), req, resp, path.Root("tags"), path.Root("tags_all"), defaults)
+		var tagsAll types.Map
+		Expect(resp.Plan.GetAttribute(context.Background(), path.Root("tags_all"), &tagsAll)).To(BeEmpty())
+		return tagsAll, resp
+	}
+
+	It("merges the default tags on creation", func() {
+		tags := mapValue(map[string]string{"owner": "me"})
+		req := resource.ModifyPlanRequest{
+			Plan:  tfsdk.Plan{Schema: testSchema, Raw: raw(tags, types.MapUnknown(types.StringType))},
+			State: nullState,
+		}
+		tagsAll, resp := run(req, defaults)
+		Expect(resp.Diagnostics).To(BeEmpty())
+		Expect(tagsAll).To(Equal(mapValue(map[string]string{"cost-center": "123", "owner": "me"})))
+	})
+
+	It("uses the defaults it is given", func() {
+		tags := mapValue(map[string]string{"owner": "me"})
+		req := resource.ModifyPlanRequest{
+			Plan:  tfsdk.Plan{Schema: testSchema, Raw: raw(tags, types.MapUnknown(types.StringType))},
+			State: nullState,
+		}
+		tagsAll, _ := run(req, map[string]string{"env": "prod"})
+		Expect(tagsAll).To(Equal(mapValue(map[string]string{"env": "prod", "owner": "me"})))
+		tagsAll, _ = run(req, nil)
+		Expect(tagsAll).To(Equal(tags))
+	})
+
+	It("keeps the tags of existing resources", func() {
+		stateValue := mapValue(map[string]string{"owner": "me"})
+		req := resource.ModifyPlanRequest{
+			Plan:  tfsdk.Plan{Schema: testSchema, Raw: raw(stateValue, types.MapUnknown(types.StringType))},
+			State: tfsdk.State{Schema: testSchema, Raw: raw(stateValue, stateValue)},
+		}
+		tagsAll, resp := run(req, defaults)
+		Expect(tagsAll).To(Equal(stateValue))
+		Expect(resp.Diagnostics.WarningsCount()).To(Equal(1))
+	})
+
+	It("doesn't plan the tags of a nested object that isn't set", func() {
+		nestedSchema := schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"aws_node_pool": schema.SingleNestedAttribute{
+					Attributes: testSchema.Attributes,
+					Optional:   true,
+				},
+			},
+		}
+		nestedType := nestedSchema.Type().TerraformType(context.Background())
+		req := resource.ModifyPlanRequest{
+			Plan: tfsdk.Plan{
+				Schema: nestedSchema,
+				Raw: tftypes.NewValue(nestedType, map[string]tftypes.Value{
+					"aws_node_pool": tftypes.NewValue(testSchema.Type().TerraformType(context.Background()), nil),
+				}),
+			},
+			State: tfsdk.State{Schema: nestedSchema, Raw: tftypes.NewValue(nestedType, nil)},
+		}
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		awsNodePool := path.Root("aws_node_pool")
+		MergeDefaultTags(context.Background(), req, resp, awsNodePool.AtName("tags"), awsNodePool.AtName("tags_all"),
+			defaults)
+		Expect(resp.Diagnostics).To(BeEmpty())
+		Expect(resp.Plan.Raw.Equal(req.Plan.Raw)).To(BeTrue())
+	})
+})
+
+var _ = Describe("Apply Default Properties Modifier", func() {
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ocm_properties": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+
+	state := func(values map[string]string) (tfsdk.State, types.Map) {
+		value, err := common.ConvertStringMapToMapType(values)
+		Expect(err).NotTo(HaveOccurred())
+		tfValue, err := value.ToTerraformValue(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{"ocm_properties": tfValue},
+			),
+		}, value
+	}
+
+	run := func(values map[string]string) types.Map {
+		s, _ := state(values)
+		req := resource.ModifyPlanRequest{
+			Plan:  tfsdk.Plan{Schema: testSchema, Raw: s.Raw},
+			State: s,
+		}
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		ApplyDefaultProperties(context.Background(), req, resp, path.Root("ocm_properties"),
+			map[string]string{"owner": "team"})
+		var ocmProperties types.Map
+		Expect(resp.Plan.GetAttribute(context.Background(), path.Root("ocm_properties"), &ocmProperties)).To(BeEmpty())
+		return ocmProperties
+	}
+
+	It("updates the cluster when a default property is missing", func() {
+		Expect(run(map[string]string{"rosa_tf_version": "1"}).IsUnknown()).To(BeTrue())
+	})
+
+	It("keeps the properties when the defaults are present", func() {
+		Expect(run(map[string]string{"rosa_tf_version": "1", "owner": "team"}).IsUnknown()).To(BeFalse())
+	})
+})
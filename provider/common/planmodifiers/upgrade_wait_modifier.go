@@ -0,0 +1,63 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package planmodifiers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+// UnknownWhenWaitingForUpgrade returns a string plan modifier for the computed attribute that
+// contains the current version of an existing resource. When the given sibling attribute asks to
+// wait for upgrades, and the desired version of the other sibling attribute is different, the
+// current version is marked as unknown because the apply will change it. It is meant to be used
+// after the modifiers that copy the value from the state.
+func UnknownWhenWaitingForUpgrade(versionAttribute, waitAttribute string) planmodifier.String {
+	return unknownWhenWaitingForUpgradeModifier{
+		versionAttribute: versionAttribute,
+		waitAttribute:    waitAttribute,
+	}
+}
+
+type unknownWhenWaitingForUpgradeModifier struct {
+	versionAttribute string
+	waitAttribute    string
+}
+
+func (m unknownWhenWaitingForUpgradeModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("Marks the value as unknown when '%s' is set and '%s' changes.",
+		m.waitAttribute, m.versionAttribute)
+}
+
+func (m unknownWhenWaitingForUpgradeModifier) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("Marks the value as unknown when `%s` is set and `%s` changes.",
+		m.waitAttribute, m.versionAttribute)
+}
+
+func (m unknownWhenWaitingForUpgradeModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Creation and destroy don't upgrade anything.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var wait types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, req.Path.ParentPath().AtName(m.waitAttribute), &wait)...)
+	if resp.Diagnostics.HasError() || !common.BoolWithFalseDefault(wait) {
+		return
+	}
+	var version types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, req.Path.ParentPath().AtName(m.versionAttribute), &version)...)
+	if resp.Diagnostics.HasError() || !common.HasValue(version) {
+		return
+	}
+	if req.StateValue.Equal(version) {
+		return
+	}
+	resp.PlanValue = types.StringUnknown()
+}
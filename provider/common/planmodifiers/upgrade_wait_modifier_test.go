@@ -0,0 +1,72 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package planmodifiers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Unknown When Waiting For Upgrade Modifier", func() {
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				Optional: true,
+			},
+			"current_version": schema.StringAttribute{
+				Computed: true,
+			},
+			"wait_for_upgrade_complete": schema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	raw := func(version string, currentVersion string, wait bool) tftypes.Value {
+		return tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			map[string]tftypes.Value{
+				"version":                   tftypes.NewValue(tftypes.String, version),
+				"current_version":           tftypes.NewValue(tftypes.String, currentVersion),
+				"wait_for_upgrade_complete": tftypes.NewValue(tftypes.Bool, wait),
+			},
+		)
+	}
+
+	run := func(version string, wait bool) types.String {
+		stateValue := types.StringValue("4.14.0")
+		req := planmodifier.StringRequest{
+			Path:       path.Root("current_version"),
+			Plan:       tfsdk.Plan{Schema: testSchema, Raw: raw(version, "4.14.0", wait)},
+			PlanValue:  stateValue,
+			State:      tfsdk.State{Schema: testSchema, Raw: raw("4.14.0", "4.14.0", wait)},
+			StateValue: stateValue,
+		}
+		resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+		UnknownWhenWaitingForUpgrade("version", "wait_for_upgrade_complete").PlanModifyString(
+			context.Background(), req, resp)
+		Expect(resp.Diagnostics).To(BeEmpty())
+		return resp.PlanValue
+	}
+
+	It("marks the current version unknown when waiting for an upgrade", func() {
+		Expect(run("4.14.1", true).IsUnknown()).To(BeTrue())
+	})
+
+	It("keeps the current version when not waiting", func() {
+		Expect(run("4.14.1", false)).To(Equal(types.StringValue("4.14.0")))
+	})
+
+	It("keeps the current version when the version doesn't change", func() {
+		Expect(run("4.14.0", true)).To(Equal(types.StringValue("4.14.0")))
+	})
+})
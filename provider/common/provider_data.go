@@ -0,0 +1,29 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"time"
+
+	sdk "github.com/openshift-online/ocm-sdk-go"
+)
+
+// ProviderData is passed by the provider to its resources and data sources when it is configured.
+// Each configuration of the provider, including aliases, has its own, so the settings of one
+// configuration never leak into the resources of another.
+type ProviderData struct {
+	// Connection is the connection to the OCM API.
+	Connection *sdk.Connection
+
+	// ClusterWaitPollingInterval is the interval between two polls of the waiters.
+	ClusterWaitPollingInterval time.Duration
+
+	// DefaultTags are the tags given in the 'default_tags' block of the provider. They are merged
+	// into the AWS tags of the clusters and machine pools.
+	DefaultTags map[string]string
+
+	// DefaultProperties are the properties given in the 'default_properties' block of the provider.
+	// They are merged into the properties of the clusters.
+	DefaultProperties map[string]string
+}
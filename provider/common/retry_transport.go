@@ -0,0 +1,164 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+)
+
+const (
+	// DefaultMaxRetries is the default number of times that a failed OCM API request is retried.
+	DefaultMaxRetries = 3
+
+	// MaxRetriesLimit is the maximum number of retries that can be configured.
+	MaxRetriesLimit = 10
+
+	// DefaultRetryMaxWait is the default maximum time to wait before retrying an OCM API request.
+	DefaultRetryMaxWait = 30 * time.Second
+
+	retryBaseInterval = time.Second
+)
+
+// RetryTransportWrapper returns a transport wrapper that retries the OCM API requests that fail
+// with a transient error. Requests rejected with 429 are retried whatever their method, as the
+// server didn't process them. Network errors, 502, 503 and 504 are only retried for idempotent
+// requests, as a proxy may return them after the request was processed. The 'Retry-After' header
+// is honored, otherwise the wait grows exponentially with some
+// jitter. No wait exceeds maxWait.
+func RetryTransportWrapper(maxRetries int, maxWait time.Duration) sdk.TransportWrapper {
+	return func(transport http.RoundTripper) http.RoundTripper {
+		if maxRetries <= 0 {
+			return transport
+		}
+		return &retryRoundTripper{
+			transport:    transport,
+			maxRetries:   maxRetries,
+			maxWait:      maxWait,
+			baseInterval: retryBaseInterval,
+		}
+	}
+}
+
+type retryRoundTripper struct {
+	transport    http.RoundTripper
+	maxRetries   int
+	maxWait      time.Duration
+	baseInterval time.Duration
+}
+
+func (t *retryRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+
+	// Keep a copy of the body so that it can be sent again:
+	var body []byte
+	if request.Body != nil && request.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptRequest := request.Clone(ctx)
+		if body != nil {
+			attemptRequest.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		response, err := t.transport.RoundTrip(attemptRequest)
+		if attempt >= t.maxRetries || !isRetryable(attemptRequest, response, err) {
+			return response, err
+		}
+
+		wait := t.retryWait(attempt, response)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Request for method %s and URL '%s' failed, retrying in %s: %v",
+				request.Method, request.URL, wait, err))
+		} else {
+			tflog.Warn(ctx, fmt.Sprintf("Request for method %s and URL '%s' failed with code %d, retrying in %s",
+				request.Method, request.URL, response.StatusCode, wait))
+			io.Copy(io.Discard, response.Body)
+			response.Body.Close()
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// retryWait returns the time to wait before the next attempt. Without 'Retry-After' the wait grows
+// exponentially up to the maximum, and a random jitter of up to half of it is applied.
+func (t *retryRoundTripper) retryWait(attempt int, response *http.Response) time.Duration {
+	if response != nil {
+		if wait, ok := parseRetryAfter(response.Header.Get("Retry-After"), time.Now()); ok {
+			return min(wait, t.maxWait)
+		}
+	}
+	wait := t.maxWait
+	if attempt < 32 {
+		if exponential := t.baseInterval << attempt; exponential > 0 && exponential < t.maxWait {
+			wait = exponential
+		}
+	}
+	half := wait / 2
+	if half <= 0 {
+		return wait
+	}
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+func isRetryable(request *http.Request, response *http.Response, err error) bool {
+	if err != nil {
+		return request.Context().Err() == nil && isIdempotent(request.Method)
+	}
+	switch response.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(request.Method)
+	}
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter parses the value of a 'Retry-After' header, which is either a number of seconds
+// or a date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	wait := date.Sub(now)
+	if wait < 0 {
+		wait = 0
+	}
+	return wait, true
+}
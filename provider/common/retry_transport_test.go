@@ -0,0 +1,138 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+)
+
+type fakeRoundTripper struct {
+	responses []*http.Response
+	bodies    []string
+}
+
+func (f *fakeRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	body := ""
+	if request.Body != nil {
+		data, err := io.ReadAll(request.Body)
+		if err != nil {
+			return nil, err
+		}
+		body = string(data)
+	}
+	f.bodies = append(f.bodies, body)
+	response := f.responses[0]
+	f.responses = f.responses[1:]
+	return response, nil
+}
+
+func fakeResponse(code int, headers map[string]string) *http.Response {
+	response := &http.Response{
+		StatusCode: code,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+	for name, value := range headers {
+		response.Header.Set(name, value)
+	}
+	return response
+}
+
+var _ = Describe("RetryTransportWrapper", func() {
+	newRequest := func(method string, body string) *http.Request {
+		request, err := http.NewRequest(method, "https://api.example.com/api/clusters_mgmt/v1/clusters",
+			strings.NewReader(body))
+		Expect(err).NotTo(HaveOccurred())
+		return request
+	}
+
+	It("retries throttled requests and sends the body again", func() {
+		fake := &fakeRoundTripper{responses: []*http.Response{
+			fakeResponse(http.StatusTooManyRequests, map[string]string{"Retry-After": "0"}),
+			fakeResponse(http.StatusOK, nil),
+		}}
+		transport := RetryTransportWrapper(3, time.Second)(fake)
+		response, err := transport.RoundTrip(newRequest(http.MethodPost, `{"name":"pool"}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		Expect(fake.bodies).To(Equal([]string{`{"name":"pool"}`, `{"name":"pool"}`}))
+	})
+
+	It("doesn't retry non idempotent requests on bad gateway", func() {
+		fake := &fakeRoundTripper{responses: []*http.Response{
+			fakeResponse(http.StatusBadGateway, nil),
+		}}
+		transport := RetryTransportWrapper(3, time.Second)(fake)
+		response, err := transport.RoundTrip(newRequest(http.MethodPatch, "{}"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusBadGateway))
+		Expect(fake.bodies).To(HaveLen(1))
+	})
+
+	It("doesn't retry non idempotent requests on service unavailable", func() {
+		fake := &fakeRoundTripper{responses: []*http.Response{
+			fakeResponse(http.StatusServiceUnavailable, nil),
+		}}
+		transport := RetryTransportWrapper(3, time.Second)(fake)
+		response, err := transport.RoundTrip(newRequest(http.MethodPost, `{"name":"cluster"}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusServiceUnavailable))
+		Expect(fake.bodies).To(HaveLen(1))
+	})
+
+	It("returns the last response when the retries are exhausted", func() {
+		fake := &fakeRoundTripper{responses: []*http.Response{
+			fakeResponse(http.StatusServiceUnavailable, nil),
+			fakeResponse(http.StatusServiceUnavailable, nil),
+			fakeResponse(http.StatusServiceUnavailable, nil),
+		}}
+		transport := RetryTransportWrapper(2, time.Millisecond)(fake)
+		response, err := transport.RoundTrip(newRequest(http.MethodGet, ""))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusServiceUnavailable))
+		Expect(fake.bodies).To(HaveLen(3))
+	})
+
+	It("caps the wait requested by the server", func() {
+		rt := &retryRoundTripper{maxWait: 10 * time.Second, baseInterval: time.Second}
+		response := fakeResponse(http.StatusTooManyRequests, map[string]string{"Retry-After": "120"})
+		Expect(rt.retryWait(0, response)).To(Equal(10 * time.Second))
+	})
+
+	It("grows the wait exponentially without 'Retry-After'", func() {
+		rt := &retryRoundTripper{maxWait: time.Minute, baseInterval: time.Second}
+		wait := rt.retryWait(3, fakeResponse(http.StatusServiceUnavailable, nil))
+		Expect(wait).To(BeNumerically(">=", 4*time.Second))
+		Expect(wait).To(BeNumerically("<=", 8*time.Second))
+	})
+
+	It("caps the exponential wait for late attempts", func() {
+		rt := &retryRoundTripper{maxWait: time.Minute, baseInterval: time.Second}
+		for _, attempt := range []int{6, 34, 63, 64, 1000} {
+			wait := rt.retryWait(attempt, fakeResponse(http.StatusServiceUnavailable, nil))
+			Expect(wait).To(BeNumerically(">=", 30*time.Second))
+			Expect(wait).To(BeNumerically("<=", time.Minute))
+		}
+	})
+
+	DescribeTable("parseRetryAfter",
+		func(value string, expected time.Duration, expectedOk bool) {
+			now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+			wait, ok := parseRetryAfter(value, now)
+			Expect(ok).To(Equal(expectedOk))
+			Expect(wait).To(Equal(expected))
+		},
+		Entry("seconds", "30", 30*time.Second, true),
+		Entry("date", "Mon, 01 Jan 2024 12:01:00 GMT", time.Minute, true),
+		Entry("past date", "Mon, 01 Jan 2024 11:00:00 GMT", time.Duration(0), true),
+		Entry("empty", "", time.Duration(0), false),
+		Entry("invalid", "soon", time.Duration(0), false),
+	)
+})
@@ -0,0 +1,48 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	awsarn "github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// RoleARN holds the components of an IAM role ARN.
+type RoleARN struct {
+	Partition string
+	AccountID string
+	// Path of the role, always starting and ending with "/".
+	Path string
+	Name string
+}
+
+// ParseRoleARN splits an IAM role ARN into its components. The role name is returned without
+// the path prefix, as expected by the IAM API.
+func ParseRoleARN(roleARN string) (RoleARN, error) {
+	parsed, err := awsarn.Parse(roleARN)
+	if err != nil {
+		return RoleARN{}, fmt.Errorf("invalid role ARN %q: %w", roleARN, err)
+	}
+	const rolePrefix = "role/"
+	if parsed.Service != "iam" || !strings.HasPrefix(parsed.Resource, rolePrefix) {
+		return RoleARN{}, fmt.Errorf("invalid role ARN %q: expected IAM role resource", roleARN)
+	}
+	roleResource := parsed.Resource[len(rolePrefix):]
+	rolePath := "/"
+	if idx := strings.LastIndex(roleResource, "/"); idx >= 0 {
+		rolePath = "/" + roleResource[:idx+1]
+		roleResource = roleResource[idx+1:]
+	}
+	if roleResource == "" {
+		return RoleARN{}, fmt.Errorf("invalid role ARN %q: missing role name", roleARN)
+	}
+	return RoleARN{
+		Partition: parsed.Partition,
+		AccountID: parsed.AccountID,
+		Path:      rolePath,
+		Name:      roleResource,
+	}, nil
+}
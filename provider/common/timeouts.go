@@ -0,0 +1,67 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TimeoutDeprecationMessage returns the deprecation message of an attribute replaced by the given
+// timeout of the 'timeouts' block.
+func TimeoutDeprecationMessage(timeout string) string {
+	return fmt.Sprintf("Use the '%s' timeout of the 'timeouts' block instead.", timeout)
+}
+
+// DurationToMinutes converts a timeout to the minutes expected by the cluster waiter, rounding
+// up so that the waiter never gives up earlier than requested.
+func DurationToMinutes(timeout time.Duration) int64 {
+	return int64(math.Ceil(timeout.Minutes()))
+}
+
+func timeoutsAttributeTypes(opts timeouts.Opts) map[string]attr.Type {
+	attributeTypes := map[string]attr.Type{}
+	if opts.Create {
+		attributeTypes["create"] = types.StringType
+	}
+	if opts.Read {
+		attributeTypes["read"] = types.StringType
+	}
+	if opts.Update {
+		attributeTypes["update"] = types.StringType
+	}
+	if opts.Delete {
+		attributeTypes["delete"] = types.StringType
+	}
+	return attributeTypes
+}
+
+// TimeoutsNull returns a null value for a 'timeouts' block with the given timeouts.
+func TimeoutsNull(opts timeouts.Opts) timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(timeoutsAttributeTypes(opts)),
+	}
+}
+
+// DataSourceTimeoutsAttribute returns the attribute used by data sources that share their state
+// with a resource that has a 'timeouts' block. The data source always sets it to null.
+func DataSourceTimeoutsAttribute(opts timeouts.Opts, description string) dsschema.Attribute {
+	attributeTypes := timeoutsAttributeTypes(opts)
+	return dsschema.ObjectAttribute{
+		Description:    description,
+		AttributeTypes: attributeTypes,
+		CustomType: timeouts.Type{
+			ObjectType: types.ObjectType{
+				AttrTypes: attributeTypes,
+			},
+		},
+		Computed: true,
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// DefaultUpgradeTimeout is the default time that resources wait for an upgrade to complete when
+// 'wait_for_upgrade_complete' is set.
+const DefaultUpgradeTimeout = 4 * time.Hour
+
+// UpgradeProgress is the progress of an upgrade, as returned by the function polled by
+// WaitForUpgrade.
+type UpgradeProgress struct {
+	// Completed indicates that the upgrade finished successfully.
+	Completed bool
+
+	// State is the state of the upgrade policy. It is empty when the policy doesn't exist
+	// anymore, which happens once the upgrade finishes.
+	State cmv1.UpgradePolicyStateValue
+
+	// Description is the description of the state of the upgrade policy.
+	Description string
+}
+
+// WaitForUpgrade polls the progress of the upgrade of the given target, for example "cluster
+// '123'", every pollingInterval until it completes, fails or the timeout expires. Polling errors
+// are retried a few times before giving up.
+func WaitForUpgrade(ctx context.Context, target string, timeout, pollingInterval time.Duration,
+	poll func(ctx context.Context) (*UpgradeProgress, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	last := &UpgradeProgress{}
+	failures := 0
+	for {
+		progress, err := poll(ctx)
+		switch {
+		case err != nil && ctx.Err() == nil:
+			failures++
+			if failures >= defaultBackoffAttempts {
+				return fmt.Errorf("failed to get the progress of the upgrade of %s: %v", target, err)
+			}
+			tflog.Warn(ctx, fmt.Sprintf("Getting the progress of the upgrade of %s failed, retrying: %v",
+				target, err))
+		case err == nil:
+			failures = 0
+			last = progress
+			if progress.Completed {
+				tflog.Info(ctx, fmt.Sprintf("Upgrade of %s completed", target))
+				return nil
+			}
+			if progress.State == cmv1.UpgradePolicyStateValueFailed {
+				return fmt.Errorf("upgrade of %s failed: %s", target, describeUpgradeState(progress))
+			}
+			tflog.Info(ctx, fmt.Sprintf("Waiting for the upgrade of %s: %s", target, describeUpgradeState(progress)))
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("upgrade of %s didn't complete within %s, the last known state is %s",
+				target, timeout, describeUpgradeState(last))
+		case <-time.After(pollingInterval):
+		}
+	}
+}
+
+func describeUpgradeState(progress *UpgradeProgress) string {
+	state := string(progress.State)
+	if state == "" {
+		state = "unknown"
+	}
+	if progress.Description == "" {
+		return fmt.Sprintf("'%s'", state)
+	}
+	return fmt.Sprintf("'%s' (%s)", state, progress.Description)
+}
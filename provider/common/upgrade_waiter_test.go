@@ -0,0 +1,60 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Upgrade waiter", func() {
+	// sequence returns a poll function that returns the given results in order, repeating the
+	// last one.
+	sequence := func(results ...*UpgradeProgress) func(context.Context) (*UpgradeProgress, error) {
+		calls := 0
+		return func(context.Context) (*UpgradeProgress, error) {
+			result := results[min(calls, len(results)-1)]
+			calls++
+			if result == nil {
+				return nil, errors.New("boom")
+			}
+			return result, nil
+		}
+	}
+
+	It("waits until the upgrade completes", func() {
+		err := WaitForUpgrade(context.Background(), "cluster '123'", time.Minute, time.Millisecond, sequence(
+			&UpgradeProgress{State: cmv1.UpgradePolicyStateValueScheduled},
+			nil,
+			&UpgradeProgress{State: cmv1.UpgradePolicyStateValueStarted},
+			&UpgradeProgress{Completed: true},
+		))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("fails when the upgrade fails", func() {
+		err := WaitForUpgrade(context.Background(), "cluster '123'", time.Minute, time.Millisecond, sequence(
+			&UpgradeProgress{State: cmv1.UpgradePolicyStateValueStarted},
+			&UpgradeProgress{State: cmv1.UpgradePolicyStateValueFailed, Description: "Nodes not ready"},
+		))
+		Expect(err).To(MatchError("upgrade of cluster '123' failed: 'failed' (Nodes not ready)"))
+	})
+
+	It("fails when polling keeps failing", func() {
+		err := WaitForUpgrade(context.Background(), "cluster '123'", time.Minute, time.Millisecond, sequence(nil))
+		Expect(err).To(MatchError(ContainSubstring("failed to get the progress of the upgrade of cluster '123'")))
+	})
+
+	It("fails when the timeout expires", func() {
+		err := WaitForUpgrade(context.Background(), "cluster '123'", 50*time.Millisecond, time.Millisecond, sequence(
+			&UpgradeProgress{State: cmv1.UpgradePolicyStateValueDelayed},
+		))
+		Expect(err).To(MatchError(ContainSubstring("didn't complete within 50ms, the last known state is 'delayed'")))
+	})
+})
@@ -0,0 +1,38 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// AgreedVersionGates returns the identifiers of the version gates that already have an agreement
+// in a cluster, for example because they were acknowledged with the 'rhcs_version_gate_agreement'
+// resource.
+func AgreedVersionGates(ctx context.Context, client *cmv1.VersionGateAgreementsClient) (map[string]bool, error) {
+	agreed := map[string]bool{}
+	page := 1
+	size := 100
+	for {
+		resp, err := client.List().
+			Page(page).
+			Size(size).
+			SendContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list version gate agreements: %v", err)
+		}
+		resp.Items().Each(func(agreement *cmv1.VersionGateAgreement) bool {
+			agreed[agreement.VersionGate().ID()] = true
+			return true
+		})
+		if resp.Size() < size {
+			break
+		}
+		page++
+	}
+	return agreed, nil
+}
@@ -0,0 +1,678 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalauthprovider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common/attrvalidators"
+)
+
+const (
+	// Same rule as the ROSA CLI: the name is used as the provider identifier
+	// and has to be a valid DNS label.
+	nameRegexpString = `^[a-z]([-a-z0-9]*[a-z0-9])?$`
+	nameMaxLength    = 15
+)
+
+var nameRegexp = regexp.MustCompile(nameRegexpString)
+
+type ExternalAuthProviderResource struct {
+	collection  *cmv1.ClustersClient
+	clusterWait common.ClusterWait
+}
+
+var _ resource.Resource = &ExternalAuthProviderResource{}
+var _ resource.ResourceWithConfigure = &ExternalAuthProviderResource{}
+var _ resource.ResourceWithImportState = &ExternalAuthProviderResource{}
+
+func New() resource.Resource {
+	return &ExternalAuthProviderResource{}
+}
+
+func (r *ExternalAuthProviderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_external_auth_provider"
+}
+
+func (r *ExternalAuthProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an external authentication provider of a ROSA HCP cluster. " +
+			"The cluster must be created with `external_auth_providers_enabled` set to `true`.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Identifier of the cluster.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`), "cluster ID may not be empty/blank string"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the external authentication provider.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: fmt.Sprintf("Name of the external authentication provider. "+
+					"Must be a valid DNS label of at most %d characters. Changing it forces a new resource.", nameMaxLength),
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(nameMaxLength),
+					stringvalidator.RegexMatches(nameRegexp, fmt.Sprintf("must match the regexp '%s'", nameRegexpString)),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"issuer": schema.SingleNestedAttribute{
+				Description: "Token issuer of the external authentication provider.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						Description: "URL of the token issuer. It must use the https scheme.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^https://\S+$`), "must be a valid https URL"),
+						},
+					},
+					"audiences": schema.ListAttribute{
+						Description: "Audiences the token issuer issues tokens for. " +
+							"At least one of them must match the 'aud' claim of the presented tokens.",
+						ElementType: types.StringType,
+						Required:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+					"ca": schema.StringAttribute{
+						Description: "PEM encoded certificate bundle used to verify the TLS connection to the token issuer.",
+						Optional:    true,
+					},
+				},
+			},
+			"claim": schema.SingleNestedAttribute{
+				Description: "Rules on how to handle the claims of the tokens issued by the token issuer.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"mappings": schema.SingleNestedAttribute{
+						Description: "Mapping of the token claims to the cluster user name and groups.",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"username": schema.SingleNestedAttribute{
+								Description: "Claim used to build the cluster user name.",
+								Optional:    true,
+								Attributes: map[string]schema.Attribute{
+									"claim": schema.StringAttribute{
+										Description: "Name of the claim, for example 'email' or 'sub'.",
+										Required:    true,
+									},
+									"prefix": schema.StringAttribute{
+										Description: "Prefix prepended to the claim value.",
+										Optional:    true,
+									},
+									"prefix_policy": schema.StringAttribute{
+										Description: "How the prefix is applied to the claim value. Options are NoPrefix,Prefix.",
+										Optional:    true,
+										Validators: []validator.String{
+											attrvalidators.EnumValueValidator([]string{"NoPrefix", "Prefix"}),
+										},
+									},
+								},
+							},
+							"groups": schema.SingleNestedAttribute{
+								Description: "Claim used to build the cluster groups of the user.",
+								Optional:    true,
+								Attributes: map[string]schema.Attribute{
+									"claim": schema.StringAttribute{
+										Description: "Name of the claim, for example 'groups'.",
+										Required:    true,
+									},
+									"prefix": schema.StringAttribute{
+										Description: "Prefix prepended to each group name.",
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+					"validation_rules": schema.ListNestedAttribute{
+						Description: "Rules the token claims must satisfy to be accepted.",
+						Optional:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"claim": schema.StringAttribute{
+									Description: "Name of the claim.",
+									Required:    true,
+								},
+								"required_value": schema.StringAttribute{
+									Description: "Value the claim must have.",
+									Required:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"clients": schema.ListNestedAttribute{
+				Description: "OIDC clients used by the cluster components, for example the console or the CLI, " +
+					"to authenticate against the token issuer.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Identifier of the OIDC client as registered in the token issuer.",
+							Required:    true,
+						},
+						"secret": schema.StringAttribute{
+							Description: "Secret of the OIDC client. Required for confidential clients.",
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"component": schema.SingleNestedAttribute{
+							Description: "Cluster component that uses the OIDC client, " +
+								"for example name 'console' in namespace 'openshift-console'.",
+							Required: true,
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Description: "Name of the component.",
+									Required:    true,
+								},
+								"namespace": schema.StringAttribute{
+									Description: "Namespace of the component.",
+									Required:    true,
+								},
+							},
+						},
+						"extra_scopes": schema.ListAttribute{
+							Description: "Scopes to request, in addition to the 'openid' scope, during the authorization token request.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the OIDC client. Options are confidential,public.",
+							Optional:    true,
+							Computed:    true,
+							Validators: []validator.String{
+								attrvalidators.EnumValueValidator([]string{
+									string(cmv1.ExternalAuthClientTypeConfidential),
+									string(cmv1.ExternalAuthClientTypePublic),
+								}),
+							},
+						},
+					},
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the external authentication provider.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true}),
+		},
+	}
+}
+
+func (r *ExternalAuthProviderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*common.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	connection := providerData.Connection
+
+	r.collection = connection.ClustersMgmt().V1().Clusters()
+	r.clusterWait = common.NewClusterWait(r.collection, connection,
+		common.WithPollingInterval(providerData.ClusterWaitPollingInterval))
+}
+
+func (r *ExternalAuthProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &ExternalAuthProviderState{}
+	diags := req.Plan.Get(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterId := plan.Cluster.ValueString()
+
+	waitTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultClusterReadyTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cluster, err := r.clusterWait.WaitForClusterToBeReady(ctx, clusterId, common.DurationToMinutes(waitTimeout))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Cannot poll cluster state",
+			fmt.Sprintf(
+				"Cannot poll state of cluster with identifier '%s': %v",
+				clusterId, err,
+			),
+		)
+		return
+	}
+	if !cluster.Hypershift().Enabled() {
+		resp.Diagnostics.AddError(
+			"Unsupported Cluster Type",
+			"External authentication providers are only supported on Hosted Control Plane clusters",
+		)
+		return
+	}
+	if !cluster.ExternalAuthConfig().Enabled() {
+		resp.Diagnostics.AddError(
+			"External Authentication Configuration is not enabled",
+			fmt.Sprintf("External Authentication Configuration is not enabled for cluster '%s'",
+				clusterId),
+		)
+		return
+	}
+
+	externalAuth, err := buildExternalAuth(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to build external authentication provider",
+			fmt.Sprintf("Failed to build external authentication provider for cluster '%s': %v", clusterId, err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Creating external authentication provider", map[string]any{
+		"cluster": clusterId,
+		"name":    plan.Name.ValueString(),
+	})
+
+	createResp, err := r.collection.Cluster(clusterId).ExternalAuthConfig().ExternalAuths().Add().
+		Body(externalAuth).SendContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create external authentication provider",
+			fmt.Sprintf(
+				"Failed to create external authentication provider '%s' for cluster '%s': %v",
+				plan.Name.ValueString(), clusterId, err,
+			),
+		)
+		return
+	}
+
+	err = populateState(createResp.Body(), plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to populate external authentication provider state",
+			fmt.Sprintf("Failed to populate external authentication provider state: %v", err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ExternalAuthProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &ExternalAuthProviderState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterId := state.Cluster.ValueString()
+	externalAuthId := state.ID.ValueString()
+
+	getResp, err := r.collection.Cluster(clusterId).ExternalAuthConfig().ExternalAuths().
+		ExternalAuth(externalAuthId).Get().SendContext(ctx)
+	if err != nil {
+		if getResp != nil && getResp.Status() == http.StatusNotFound {
+			tflog.Warn(ctx, "External authentication provider not found, removing from state", map[string]any{
+				"cluster":            clusterId,
+				"external_auth_name": externalAuthId,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to fetch external authentication provider",
+			fmt.Sprintf(
+				"Failed to fetch external authentication provider '%s' for cluster '%s': %v",
+				externalAuthId, clusterId, err,
+			),
+		)
+		return
+	}
+
+	err = populateState(getResp.Body(), state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to populate external authentication provider state",
+			fmt.Sprintf("Failed to populate external authentication provider state: %v", err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ExternalAuthProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	state := &ExternalAuthProviderState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan := &ExternalAuthProviderState{}
+	diags = req.Plan.Get(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterId := state.Cluster.ValueString()
+	externalAuthId := state.ID.ValueString()
+
+	externalAuth, err := buildExternalAuth(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to build external authentication provider",
+			fmt.Sprintf("Failed to build external authentication provider update for cluster '%s': %v", clusterId, err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Updating external authentication provider", map[string]any{
+		"cluster":            clusterId,
+		"external_auth_name": externalAuthId,
+	})
+
+	updateResp, err := r.collection.Cluster(clusterId).ExternalAuthConfig().ExternalAuths().
+		ExternalAuth(externalAuthId).Update().Body(externalAuth).SendContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update external authentication provider",
+			fmt.Sprintf(
+				"Failed to update external authentication provider '%s' for cluster '%s': %v",
+				externalAuthId, clusterId, err,
+			),
+		)
+		return
+	}
+
+	err = populateState(updateResp.Body(), plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to populate external authentication provider state",
+			fmt.Sprintf("Failed to populate external authentication provider state: %v", err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ExternalAuthProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &ExternalAuthProviderState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterId := state.Cluster.ValueString()
+	externalAuthId := state.ID.ValueString()
+
+	tflog.Debug(ctx, "Deleting external authentication provider", map[string]any{
+		"cluster":            clusterId,
+		"external_auth_name": externalAuthId,
+	})
+
+	deleteResp, err := r.collection.Cluster(clusterId).ExternalAuthConfig().ExternalAuths().
+		ExternalAuth(externalAuthId).Delete().SendContext(ctx)
+	if err != nil {
+		if deleteResp != nil && deleteResp.Status() == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to delete external authentication provider",
+			fmt.Sprintf(
+				"Failed to delete external authentication provider '%s' for cluster '%s': %v",
+				externalAuthId, clusterId, err,
+			),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ExternalAuthProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fields := strings.Split(req.ID, ",")
+	if len(fields) != 2 || fields[0] == "" || fields[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import identifier",
+			"External authentication provider to import should be specified as <cluster_id>,<external_auth_provider_name>",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster"), fields[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fields[1])...)
+}
+
+// buildExternalAuth builds the OCM external authentication object from the Terraform plan. Lists
+// are always set, even when empty, so that removing them from the configuration clears them on
+// update.
+func buildExternalAuth(ctx context.Context, plan *ExternalAuthProviderState) (*cmv1.ExternalAuth, error) {
+	builder := cmv1.NewExternalAuth().ID(plan.Name.ValueString())
+
+	audiences, err := common.StringListToArray(ctx, plan.Issuer.Audiences)
+	if err != nil {
+		return nil, err
+	}
+	issuerBuilder := cmv1.NewTokenIssuer().
+		URL(plan.Issuer.URL.ValueString()).
+		Audiences(audiences...)
+	if common.HasValue(plan.Issuer.CA) {
+		issuerBuilder.CA(plan.Issuer.CA.ValueString())
+	}
+	builder.Issuer(issuerBuilder)
+
+	claimBuilder := cmv1.NewExternalAuthClaim()
+	rules := []*cmv1.TokenClaimValidationRuleBuilder{}
+	if plan.Claim != nil {
+		if plan.Claim.Mappings != nil {
+			mappingsBuilder := cmv1.NewTokenClaimMappings()
+			if username := plan.Claim.Mappings.Username; username != nil {
+				usernameBuilder := cmv1.NewUsernameClaim().Claim(username.Claim.ValueString())
+				if common.HasValue(username.Prefix) {
+					usernameBuilder.Prefix(username.Prefix.ValueString())
+				}
+				if common.HasValue(username.PrefixPolicy) {
+					usernameBuilder.PrefixPolicy(username.PrefixPolicy.ValueString())
+				}
+				mappingsBuilder.UserName(usernameBuilder)
+			}
+			if groups := plan.Claim.Mappings.Groups; groups != nil {
+				groupsBuilder := cmv1.NewGroupsClaim().Claim(groups.Claim.ValueString())
+				if common.HasValue(groups.Prefix) {
+					groupsBuilder.Prefix(groups.Prefix.ValueString())
+				}
+				mappingsBuilder.Groups(groupsBuilder)
+			}
+			claimBuilder.Mappings(mappingsBuilder)
+		}
+		for _, rule := range plan.Claim.ValidationRules {
+			rules = append(rules, cmv1.NewTokenClaimValidationRule().
+				Claim(rule.Claim.ValueString()).
+				RequiredValue(rule.RequiredValue.ValueString()))
+		}
+	}
+	claimBuilder.ValidationRules(rules...)
+	builder.Claim(claimBuilder)
+
+	clients := []*cmv1.ExternalAuthClientConfigBuilder{}
+	for _, client := range plan.Clients {
+		clientBuilder := cmv1.NewExternalAuthClientConfig().ID(client.ID.ValueString())
+		if common.HasValue(client.Secret) {
+			clientBuilder.Secret(client.Secret.ValueString())
+		}
+		if client.Component != nil {
+			clientBuilder.Component(cmv1.NewClientComponent().
+				Name(client.Component.Name.ValueString()).
+				Namespace(client.Component.Namespace.ValueString()))
+		}
+		extraScopes, err := common.StringListToArray(ctx, client.ExtraScopes)
+		if err != nil {
+			return nil, err
+		}
+		clientBuilder.ExtraScopes(extraScopes...)
+		if common.HasValue(client.Type) {
+			clientBuilder.Type(cmv1.ExternalAuthClientType(client.Type.ValueString()))
+		}
+		clients = append(clients, clientBuilder)
+	}
+	builder.Clients(clients...)
+
+	return builder.Build()
+}
+
+// populateState copies the external authentication object returned by OCM into the state. Client
+// secrets are never returned by the API, so the values already known in the state are kept.
+func populateState(object *cmv1.ExternalAuth, state *ExternalAuthProviderState) error {
+	state.ID = types.StringValue(object.ID())
+	state.Name = types.StringValue(object.ID())
+
+	issuer := object.Issuer()
+	audiences, err := common.StringArrayToList(issuer.Audiences())
+	if err != nil {
+		return err
+	}
+	ca := common.EmptiableStringToStringType(issuer.CA())
+	if state.Issuer != nil && ca.IsNull() {
+		ca = state.Issuer.CA
+	}
+	state.Issuer = &TokenIssuer{
+		URL:       types.StringValue(issuer.URL()),
+		Audiences: audiences,
+		CA:        ca,
+	}
+
+	state.Claim = flattenClaim(object.Claim(), state.Claim)
+
+	secrets := map[string]types.String{}
+	for _, client := range state.Clients {
+		secrets[client.ID.ValueString()] = client.Secret
+	}
+	var clients []*ClientConfig
+	for _, client := range object.Clients() {
+		clientState := &ClientConfig{
+			ID:          types.StringValue(client.ID()),
+			Secret:      common.EmptiableStringToStringType(client.Secret()),
+			ExtraScopes: types.ListNull(types.StringType),
+			Type:        common.EmptiableStringToStringType(string(client.Type())),
+		}
+		if secret, ok := secrets[client.ID()]; ok && clientState.Secret.IsNull() {
+			clientState.Secret = secret
+		}
+		if component, ok := client.GetComponent(); ok {
+			clientState.Component = &ClientComponent{
+				Name:      types.StringValue(component.Name()),
+				Namespace: types.StringValue(component.Namespace()),
+			}
+		}
+		if len(client.ExtraScopes()) > 0 {
+			clientState.ExtraScopes, err = common.StringArrayToList(client.ExtraScopes())
+			if err != nil {
+				return err
+			}
+		}
+		clients = append(clients, clientState)
+	}
+	state.Clients = clients
+
+	state.State = types.StringNull()
+	if externalAuthState, ok := object.Status().GetState(); ok {
+		state.State = common.EmptiableStringToStringType(externalAuthState.Value())
+	}
+	return nil
+}
+
+// flattenClaim converts the claim returned by OCM. An empty claim is only kept as an empty block
+// when it was already configured that way, to avoid a permanent diff.
+func flattenClaim(claim *cmv1.ExternalAuthClaim, current *Claim) *Claim {
+	result := &Claim{}
+	if mappings, ok := claim.GetMappings(); ok {
+		mappingsState := &ClaimMappings{}
+		if username, ok := mappings.GetUserName(); ok {
+			mappingsState.Username = &UsernameClaim{
+				Claim:        types.StringValue(username.Claim()),
+				Prefix:       common.EmptiableStringToStringType(username.Prefix()),
+				PrefixPolicy: common.EmptiableStringToStringType(username.PrefixPolicy()),
+			}
+		}
+		if groups, ok := mappings.GetGroups(); ok {
+			mappingsState.Groups = &GroupsClaim{
+				Claim:  types.StringValue(groups.Claim()),
+				Prefix: common.EmptiableStringToStringType(groups.Prefix()),
+			}
+		}
+		if mappingsState.Username != nil || mappingsState.Groups != nil ||
+			(current != nil && current.Mappings != nil) {
+			result.Mappings = mappingsState
+		}
+	}
+	for _, rule := range claim.ValidationRules() {
+		result.ValidationRules = append(result.ValidationRules, &ClaimValidationRule{
+			Claim:         types.StringValue(rule.Claim()),
+			RequiredValue: types.StringValue(rule.RequiredValue()),
+		})
+	}
+	if result.Mappings == nil && len(result.ValidationRules) == 0 && current == nil {
+		return nil
+	}
+	return result
+}
@@ -0,0 +1,79 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalauthprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ExternalAuthProviderState struct {
+	Cluster types.String    `tfsdk:"cluster"`
+	ID      types.String    `tfsdk:"id"`
+	Name    types.String    `tfsdk:"name"`
+	Issuer  *TokenIssuer    `tfsdk:"issuer"`
+	Claim   *Claim          `tfsdk:"claim"`
+	Clients []*ClientConfig `tfsdk:"clients"`
+	State   types.String    `tfsdk:"state"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type TokenIssuer struct {
+	URL       types.String `tfsdk:"url"`
+	Audiences types.List   `tfsdk:"audiences"`
+	CA        types.String `tfsdk:"ca"`
+}
+
+type Claim struct {
+	Mappings        *ClaimMappings         `tfsdk:"mappings"`
+	ValidationRules []*ClaimValidationRule `tfsdk:"validation_rules"`
+}
+
+type ClaimMappings struct {
+	Username *UsernameClaim `tfsdk:"username"`
+	Groups   *GroupsClaim   `tfsdk:"groups"`
+}
+
+type UsernameClaim struct {
+	Claim        types.String `tfsdk:"claim"`
+	Prefix       types.String `tfsdk:"prefix"`
+	PrefixPolicy types.String `tfsdk:"prefix_policy"`
+}
+
+type GroupsClaim struct {
+	Claim  types.String `tfsdk:"claim"`
+	Prefix types.String `tfsdk:"prefix"`
+}
+
+type ClaimValidationRule struct {
+	Claim         types.String `tfsdk:"claim"`
+	RequiredValue types.String `tfsdk:"required_value"`
+}
+
+type ClientConfig struct {
+	ID          types.String     `tfsdk:"id"`
+	Secret      types.String     `tfsdk:"secret"`
+	Component   *ClientComponent `tfsdk:"component"`
+	ExtraScopes types.List       `tfsdk:"extra_scopes"`
+	Type        types.String     `tfsdk:"type"`
+}
+
+type ClientComponent struct {
+	Name      types.String `tfsdk:"name"`
+	Namespace types.String `tfsdk:"namespace"`
+}
@@ -0,0 +1,59 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+type CompareVersionsFunction struct{}
+
+var _ function.Function = &CompareVersionsFunction{}
+
+func NewCompareVersions() function.Function {
+	return &CompareVersionsFunction{}
+}
+
+func (f *CompareVersionsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "compare_versions"
+}
+
+func (f *CompareVersionsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compares two OpenShift versions.",
+		MarkdownDescription: "Compares two OpenShift versions and returns `-1`, `0` or `1` when the first version is " +
+			"lower than, equal to or greater than the second one. Versions can be given with or without the " +
+			"`openshift-v` prefix, for example `4.14.1` or `openshift-v4.14.1`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "version1",
+				Description: "First version to compare.",
+			},
+			function.StringParameter{
+				Name:        "version2",
+				Description: "Second version to compare.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *CompareVersionsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var version1, version2 string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &version1, &version2))
+	if resp.Error != nil {
+		return
+	}
+	result, err := common.CompareVersions(version1, version2)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Can't compare versions '%s' and '%s': %v", version1, version2, err))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, int64(result)))
+}
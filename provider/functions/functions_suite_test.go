@@ -0,0 +1,16 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package functions
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+)
+
+func TestFunctions(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Functions Suite")
+}
@@ -0,0 +1,127 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+)
+
+func run(f function.Function, result attr.Value, args ...attr.Value) *function.RunResponse {
+	resp := &function.RunResponse{
+		Result: function.NewResultData(result),
+	}
+	f.Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData(args),
+	}, resp)
+	return resp
+}
+
+var _ = Describe("Provider functions", func() {
+	Context("operator_role_name", func() {
+		It("joins the prefix, namespace and name", func() {
+			resp := run(NewOperatorRoleName(), types.StringUnknown(),
+				types.StringValue("my-prefix"),
+				types.StringValue("openshift-ingress-operator"),
+				types.StringValue("cloud-credentials"))
+			Expect(resp.Error).To(BeNil())
+			Expect(resp.Result.Value()).To(Equal(types.StringValue("my-prefix-openshift-ingress-operator-cloud-credentials")))
+		})
+
+		It("truncates the role name to 64 characters", func() {
+			resp := run(NewOperatorRoleName(), types.StringUnknown(),
+				types.StringValue(strings.Repeat("a", 40)),
+				types.StringValue("openshift-cluster-csi-drivers"),
+				types.StringValue("ebs-cloud-credentials"))
+			Expect(resp.Error).To(BeNil())
+			Expect(resp.Result.Value().(types.String).ValueString()).To(HaveLen(64))
+		})
+	})
+
+	Context("compare_versions", func() {
+		DescribeTable("compares versions",
+			func(version1, version2 string, expected int64) {
+				resp := run(NewCompareVersions(), types.Int64Unknown(),
+					types.StringValue(version1), types.StringValue(version2))
+				Expect(resp.Error).To(BeNil())
+				Expect(resp.Result.Value()).To(Equal(types.Int64Value(expected)))
+			},
+			Entry("lower", "4.13.10", "4.14.1", int64(-1)),
+			Entry("equal with prefix", "openshift-v4.14.1", "4.14.1", int64(0)),
+			Entry("greater", "4.14.10", "4.14.9", int64(1)),
+		)
+
+		It("fails on an invalid version", func() {
+			resp := run(NewCompareVersions(), types.Int64Unknown(),
+				types.StringValue("invalid"), types.StringValue("4.14.1"))
+			Expect(resp.Error).ToNot(BeNil())
+			Expect(resp.Error.Error()).To(ContainSubstring("Can't compare versions 'invalid' and '4.14.1'"))
+		})
+	})
+
+	Context("parse_role_arn", func() {
+		It("parses a role ARN with a path", func() {
+			resp := run(NewParseRoleARN(), types.ObjectUnknown(roleARNAttributeTypes),
+				types.StringValue("arn:aws-us-gov:iam::123456789012:role/path/to/my-role"))
+			Expect(resp.Error).To(BeNil())
+			Expect(resp.Result.Value()).To(Equal(types.ObjectValueMust(roleARNAttributeTypes, map[string]attr.Value{
+				"partition":  types.StringValue("aws-us-gov"),
+				"account_id": types.StringValue("123456789012"),
+				"path":       types.StringValue("/path/to/"),
+				"name":       types.StringValue("my-role"),
+			})))
+		})
+
+		It("parses a role ARN without a path", func() {
+			resp := run(NewParseRoleARN(), types.ObjectUnknown(roleARNAttributeTypes),
+				types.StringValue("arn:aws:iam::123456789012:role/my-role"))
+			Expect(resp.Error).To(BeNil())
+			value := resp.Result.Value().(types.Object).Attributes()
+			Expect(value["path"]).To(Equal(types.StringValue("/")))
+			Expect(value["name"]).To(Equal(types.StringValue("my-role")))
+		})
+
+		It("fails on a non-role ARN", func() {
+			resp := run(NewParseRoleARN(), types.ObjectUnknown(roleARNAttributeTypes),
+				types.StringValue("arn:aws:iam::123456789012:user/my-user"))
+			Expect(resp.Error).ToNot(BeNil())
+			Expect(resp.Error.Error()).To(ContainSubstring("expected IAM role resource"))
+		})
+	})
+
+	Context("is_valid_domain_prefix", func() {
+		DescribeTable("validates the domain prefix",
+			func(domainPrefix string, expected bool) {
+				resp := run(NewIsValidDomainPrefix(), types.BoolUnknown(), types.StringValue(domainPrefix))
+				Expect(resp.Error).To(BeNil())
+				Expect(resp.Result.Value()).To(Equal(types.BoolValue(expected)))
+			},
+			Entry("valid", "my-cluster-1", true),
+			Entry("too long", "my-cluster-prefix", false),
+			Entry("uppercase", "MyCluster", false),
+			Entry("starts with a digit", "1cluster", false),
+			Entry("ends with a dash", "cluster-", false),
+			Entry("contains a dot", "my.cluster", false),
+			Entry("empty", "", false),
+		)
+	})
+
+	Context("oidc_endpoint_url", func() {
+		DescribeTable("removes the scheme of the issuer URL",
+			func(issuerURL, expected string) {
+				resp := run(NewOIDCEndpointURL(), types.StringUnknown(), types.StringValue(issuerURL))
+				Expect(resp.Error).To(BeNil())
+				Expect(resp.Result.Value()).To(Equal(types.StringValue(expected)))
+			},
+			Entry("with scheme", "https://oidc.example.com/123456", "oidc.example.com/123456"),
+			Entry("without scheme", "oidc.example.com/123456", "oidc.example.com/123456"),
+		)
+	})
+})
@@ -0,0 +1,50 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	rosa "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common"
+)
+
+type IsValidDomainPrefixFunction struct{}
+
+var _ function.Function = &IsValidDomainPrefixFunction{}
+
+func NewIsValidDomainPrefix() function.Function {
+	return &IsValidDomainPrefixFunction{}
+}
+
+func (f *IsValidDomainPrefixFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "is_valid_domain_prefix"
+}
+
+func (f *IsValidDomainPrefixFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Checks if a value can be used as the domain prefix of a cluster.",
+		MarkdownDescription: fmt.Sprintf("Returns `true` when the value can be used as the `domain_prefix` of a cluster: "+
+			"a lowercase DNS label that starts with a letter, ends with a letter or a digit, "+
+			"and doesn't exceed %d characters in length.", rosa.MaxClusterDomainPrefixLength),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "domain_prefix",
+				Description: "Domain prefix to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *IsValidDomainPrefixFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var domainPrefix string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &domainPrefix))
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, rosa.IsValidDomainPrefix(domainPrefix)))
+}
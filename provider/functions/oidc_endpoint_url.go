@@ -0,0 +1,49 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+type OIDCEndpointURLFunction struct{}
+
+var _ function.Function = &OIDCEndpointURLFunction{}
+
+func NewOIDCEndpointURL() function.Function {
+	return &OIDCEndpointURLFunction{}
+}
+
+func (f *OIDCEndpointURLFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "oidc_endpoint_url"
+}
+
+func (f *OIDCEndpointURLFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Returns the OIDC endpoint URL of an OIDC issuer URL.",
+		MarkdownDescription: "Returns the OIDC endpoint URL of an OIDC issuer URL, the same way as the `oidc_endpoint_url` " +
+			"attribute of the `rhcs_rosa_oidc_config` resource: the issuer URL without its `https://` scheme. " +
+			"It is the value expected by the AWS IAM OIDC provider and by the trust policies of the operator roles.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "issuer_url",
+				Description: "OIDC issuer URL, for example `https://oidc.example.com/2a0bc3b4d5e6f7g8h9i0j1k2l3m4n5o6`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *OIDCEndpointURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var issuerURL string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &issuerURL))
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, common.OIDCEndpointURL(issuerURL)))
+}
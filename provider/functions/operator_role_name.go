@@ -0,0 +1,56 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+type OperatorRoleNameFunction struct{}
+
+var _ function.Function = &OperatorRoleNameFunction{}
+
+func NewOperatorRoleName() function.Function {
+	return &OperatorRoleNameFunction{}
+}
+
+func (f *OperatorRoleNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "operator_role_name"
+}
+
+func (f *OperatorRoleNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Returns the IAM role name of a cluster operator.",
+		MarkdownDescription: "Returns the name of the IAM role used by a cluster operator, built the same way as " +
+			"the `rhcs_rosa_operator_roles` data sources: `<prefix>-<namespace>-<name>`, truncated to 64 characters.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "prefix",
+				Description: "Operator role prefix, usually the `operator_role_prefix` of the cluster.",
+			},
+			function.StringParameter{
+				Name:        "namespace",
+				Description: "Namespace of the operator, for example `openshift-ingress-operator`.",
+			},
+			function.StringParameter{
+				Name:        "name",
+				Description: "Name of the operator credentials, for example `cloud-credentials`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *OperatorRoleNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var prefix, namespace, name string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &prefix, &namespace, &name))
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, common.OperatorRoleName(prefix, namespace, name)))
+}
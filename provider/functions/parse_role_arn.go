@@ -0,0 +1,77 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+type ParseRoleARNFunction struct{}
+
+var _ function.Function = &ParseRoleARNFunction{}
+
+type RoleARN struct {
+	Partition types.String `tfsdk:"partition"`
+	AccountID types.String `tfsdk:"account_id"`
+	Path      types.String `tfsdk:"path"`
+	Name      types.String `tfsdk:"name"`
+}
+
+var roleARNAttributeTypes = map[string]attr.Type{
+	"partition":  types.StringType,
+	"account_id": types.StringType,
+	"path":       types.StringType,
+	"name":       types.StringType,
+}
+
+func NewParseRoleARN() function.Function {
+	return &ParseRoleARNFunction{}
+}
+
+func (f *ParseRoleARNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_role_arn"
+}
+
+func (f *ParseRoleARNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parses an IAM role ARN.",
+		MarkdownDescription: "Parses an IAM role ARN and returns an object with its `partition`, `account_id`, " +
+			"`path` and `name`. The `path` always starts and ends with `/`, and the `name` doesn't include the path.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "arn",
+				Description: "IAM role ARN, for example `arn:aws:iam::123456789012:role/path/my-role`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: roleARNAttributeTypes,
+		},
+	}
+}
+
+func (f *ParseRoleARNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arn string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &arn))
+	if resp.Error != nil {
+		return
+	}
+	parsed, err := common.ParseRoleARN(arn)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	result := RoleARN{
+		Partition: types.StringValue(parsed.Partition),
+		AccountID: types.StringValue(parsed.AccountID),
+		Path:      types.StringValue(parsed.Path),
+		Name:      types.StringValue(parsed.Name),
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package groupmembership
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -25,4 +26,6 @@ type GroupMembershipState struct {
 	Group   types.String `tfsdk:"group"`
 	ID      types.String `tfsdk:"id"`
 	User    types.String `tfsdk:"user"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
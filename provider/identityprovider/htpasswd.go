@@ -10,8 +10,10 @@ import (
 	"reflect"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -19,6 +21,7 @@ import (
 	idputils "github.com/openshift-online/ocm-common/pkg/idp/utils"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common/attrvalidators"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/identityprovider/htpasswd"
 )
@@ -48,8 +51,10 @@ var (
 )
 
 type HTPasswdUser struct {
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWo        types.String `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64  `tfsdk:"password_wo_version"`
 }
 
 type HTPasswdIdentityProvider struct {
@@ -77,10 +82,29 @@ var htpasswdUserList = map[string]schema.Attribute{
 		Validators:  HTPasswdUsernameValidators,
 	},
 	"password": schema.StringAttribute{
-		Description: "User password.",
-		Required:    true,
+		Description: "User password. Exactly one of `password` or `password_wo` must be set.",
+		Optional:    true,
 		Sensitive:   true,
-		Validators:  HTPasswdPasswordValidators,
+		Validators: append([]validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("password_wo")),
+		}, HTPasswdPasswordValidators...),
+	},
+	"password_wo": schema.StringAttribute{
+		Description: "User password, the value is never stored in the Terraform state. " +
+			"It can be used instead of `password` to provide the password from an ephemeral source. " +
+			"Requires Terraform 1.11 or later.",
+		Optional:   true,
+		Sensitive:  true,
+		WriteOnly:  true,
+		Validators: HTPasswdPasswordValidators,
+	},
+	"password_wo_version": schema.Int64Attribute{
+		Description: "Version of `password_wo`. As the password isn't stored in the Terraform state, " +
+			"change this value to update the user password to the current value of `password_wo`.",
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("password_wo")),
+		},
 	},
 }
 
@@ -106,6 +130,44 @@ func CreateHTPasswdIDPBuilder(ctx context.Context, state *HTPasswdIdentityProvid
 	return builder, nil
 }
 
+// Returns a copy of the planned identity provider where the password of the users
+// with a write-only password is taken from the configuration, as write-only values
+// are never part of the plan. The password is only skipped for users that already
+// had a write-only password with the same non-null `password_wo_version`, so
+// unchanged users aren't updated on every apply, and users moving from `password`
+// to `password_wo` don't end up with an empty password.
+func withWriteOnlyPasswords(plan, config, state *HTPasswdIdentityProvider) *HTPasswdIdentityProvider {
+	if plan == nil || config == nil {
+		return plan
+	}
+	configPasswords := map[string]types.String{}
+	for _, user := range config.Users {
+		configPasswords[user.Username.ValueString()] = user.PasswordWo
+	}
+	stateUsers := map[string]HTPasswdUser{}
+	if state != nil {
+		for _, user := range state.Users {
+			stateUsers[user.Username.ValueString()] = user
+		}
+	}
+
+	resolved := &HTPasswdIdentityProvider{Users: make([]HTPasswdUser, len(plan.Users))}
+	for i, user := range plan.Users {
+		resolved.Users[i] = user
+		passwordWo, ok := configPasswords[user.Username.ValueString()]
+		if !ok || !common.HasValue(passwordWo) {
+			continue
+		}
+		stateUser, exists := stateUsers[user.Username.ValueString()]
+		if exists && !common.HasValue(stateUser.Password) && common.HasValue(stateUser.PasswordWoVersion) &&
+			stateUser.PasswordWoVersion.Equal(user.PasswordWoVersion) {
+			continue
+		}
+		resolved.Users[i].Password = passwordWo
+	}
+	return resolved
+}
+
 func uniqueUsernameValidator() validator.List {
 	return attrvalidators.NewListValidator("userlist unique username", func(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
 		usersList := req.ConfigValue
@@ -0,0 +1,74 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package identityprovider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestIdentityProvider(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Identity Provider Suite")
+}
+
+var _ = Describe("withWriteOnlyPasswords", func() {
+	user := func(password, passwordWo types.String, version types.Int64) HTPasswdUser {
+		return HTPasswdUser{
+			Username:          types.StringValue("my-user"),
+			Password:          password,
+			PasswordWo:        passwordWo,
+			PasswordWoVersion: version,
+		}
+	}
+	idp := func(users ...HTPasswdUser) *HTPasswdIdentityProvider {
+		return &HTPasswdIdentityProvider{Users: users}
+	}
+
+	// Write-only values are always null in the plan and the state:
+	plan := func(version types.Int64) *HTPasswdIdentityProvider {
+		return idp(user(types.StringNull(), types.StringNull(), version))
+	}
+	config := func(version types.Int64) *HTPasswdIdentityProvider {
+		return idp(user(types.StringNull(), types.StringValue("new-password"), version))
+	}
+
+	It("Takes the password of new users from the configuration", func() {
+		resolved := withWriteOnlyPasswords(plan(types.Int64Null()), config(types.Int64Null()), idp())
+		Expect(resolved.Users[0].Password.ValueString()).To(Equal("new-password"))
+	})
+
+	It("Skips users whose version didn't change", func() {
+		state := idp(user(types.StringNull(), types.StringNull(), types.Int64Value(1)))
+		resolved := withWriteOnlyPasswords(plan(types.Int64Value(1)), config(types.Int64Value(1)), state)
+		Expect(resolved.Users[0].Password.IsNull()).To(BeTrue())
+	})
+
+	It("Takes the password when the version changes", func() {
+		state := idp(user(types.StringNull(), types.StringNull(), types.Int64Value(1)))
+		resolved := withWriteOnlyPasswords(plan(types.Int64Value(2)), config(types.Int64Value(2)), state)
+		Expect(resolved.Users[0].Password.ValueString()).To(Equal("new-password"))
+	})
+
+	It("Takes the password of users moving from a plain password without a version", func() {
+		state := idp(user(types.StringValue("old-password"), types.StringNull(), types.Int64Null()))
+		resolved := withWriteOnlyPasswords(plan(types.Int64Null()), config(types.Int64Null()), state)
+		Expect(resolved.Users[0].Password.ValueString()).To(Equal("new-password"))
+	})
+
+	It("Takes the password when the state has no version", func() {
+		state := idp(user(types.StringNull(), types.StringNull(), types.Int64Null()))
+		resolved := withWriteOnlyPasswords(plan(types.Int64Null()), config(types.Int64Null()), state)
+		Expect(resolved.Users[0].Password.ValueString()).To(Equal("new-password"))
+	})
+
+	It("Keeps plain passwords", func() {
+		plain := idp(user(types.StringValue("password"), types.StringNull(), types.Int64Null()))
+		resolved := withWriteOnlyPasswords(plain, plain, plain)
+		Expect(resolved.Users[0].Password.ValueString()).To(Equal("password"))
+	})
+})
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"reflect"
 	"regexp"
 	"strings"
 	"time"
@@ -29,11 +30,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
@@ -55,6 +58,16 @@ var listOfIDPTypesPathes = []path.Expression{
 	path.MatchRoot("openid"),
 }
 
+// The type of an identity provider can't be changed, the identity provider is replaced when the
+// block of its type is added or removed.
+var requiresReplaceOnTypeChange = objectplanmodifier.RequiresReplaceIf(
+	func(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+		resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+	},
+	"The identity provider is replaced when its type changes.",
+	"The identity provider is replaced when its type changes.",
+)
+
 type IdentityProviderResource struct {
 	collection *cmv1.ClustersClient
 }
@@ -73,6 +86,9 @@ func (r *IdentityProviderResource) Schema(ctx context.Context, req resource.Sche
 			"cluster": schema.StringAttribute{
 				Description: "Identifier of the cluster.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`), "cluster ID may not be empty/blank string"),
 				},
@@ -84,6 +100,9 @@ func (r *IdentityProviderResource) Schema(ctx context.Context, req resource.Sche
 			"name": schema.StringAttribute{
 				Description: "Name of the identity provider.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"mapping_method": schema.StringAttribute{
 				Description: "Specifies how new identities are mapped to users when they log in. Options are `add`, `claim`, `generate` and `lookup`. (default is `claim`)",
@@ -98,6 +117,9 @@ func (r *IdentityProviderResource) Schema(ctx context.Context, req resource.Sche
 				Description: "Details of the 'htpasswd' identity provider.",
 				Attributes:  htpasswdSchema,
 				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					requiresReplaceOnTypeChange,
+				},
 				Validators: []validator.Object{
 					objectvalidator.ExactlyOneOf(listOfIDPTypesPathes...),
 				},
@@ -106,6 +128,9 @@ func (r *IdentityProviderResource) Schema(ctx context.Context, req resource.Sche
 				Description: "Details of the Gitlab identity provider.",
 				Attributes:  gitlabSchema,
 				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					requiresReplaceOnTypeChange,
+				},
 				Validators: []validator.Object{
 					objectvalidator.ExactlyOneOf(listOfIDPTypesPathes...),
 				},
@@ -114,6 +139,9 @@ func (r *IdentityProviderResource) Schema(ctx context.Context, req resource.Sche
 				Description: "Details of the Github identity provider.",
 				Attributes:  githubSchema,
 				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					requiresReplaceOnTypeChange,
+				},
 				Validators: []validator.Object{
 					objectvalidator.ExactlyOneOf(listOfIDPTypesPathes...),
 				},
@@ -122,6 +150,9 @@ func (r *IdentityProviderResource) Schema(ctx context.Context, req resource.Sche
 				Description: "Details of the Google identity provider.",
 				Attributes:  googleSchema,
 				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					requiresReplaceOnTypeChange,
+				},
 				Validators: []validator.Object{
 					objectvalidator.ExactlyOneOf(listOfIDPTypesPathes...),
 				},
@@ -130,6 +161,9 @@ func (r *IdentityProviderResource) Schema(ctx context.Context, req resource.Sche
 				Description: "Details of the LDAP identity provider.",
 				Attributes:  ldapSchema,
 				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					requiresReplaceOnTypeChange,
+				},
 				Validators: []validator.Object{
 					objectvalidator.ExactlyOneOf(listOfIDPTypesPathes...),
 				},
@@ -138,6 +172,9 @@ func (r *IdentityProviderResource) Schema(ctx context.Context, req resource.Sche
 				Description: "Details of the OpenID identity provider.",
 				Attributes:  openidSchema,
 				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					requiresReplaceOnTypeChange,
+				},
 				Validators: []validator.Object{
 					objectvalidator.ExactlyOneOf(listOfIDPTypesPathes...),
 				},
@@ -152,13 +189,14 @@ func (r *IdentityProviderResource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	collection, ok := req.ProviderData.(*sdk.Connection)
+	providerData, ok := req.ProviderData.(*common.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.Connaction, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
+	collection := providerData.Connection
 
 	r.collection = collection.ClustersMgmt().V1().Clusters()
 }
@@ -226,55 +264,23 @@ func (r *IdentityProviderResource) Create(ctx context.Context, request resource.
 		mappingMethod = state.MappingMethod.ValueString()
 	}
 	builder.MappingMethod(cmv1.IdentityProviderMappingMethod(mappingMethod))
-	switch {
-	case state.HTPasswd != nil:
-		builder.Type(cmv1.IdentityProviderTypeHtpasswd)
-		htpasswdBuilder, err := CreateHTPasswdIDPBuilder(ctx, state.HTPasswd)
-		if err != nil {
-			response.Diagnostics.AddError(err.Error(), err.Error())
-			return
-		}
-		builder.Htpasswd(htpasswdBuilder)
-	case state.Gitlab != nil:
-		builder.Type(cmv1.IdentityProviderTypeGitlab)
-		gitlabBuilder, err := CreateGitlabIDPBuilder(ctx, state.Gitlab)
-		if err != nil {
-			response.Diagnostics.AddError(err.Error(), err.Error())
-			return
-		}
-		builder.Gitlab(gitlabBuilder)
-	case state.Github != nil:
-		builder.Type(cmv1.IdentityProviderTypeGithub)
-		githubBuilder, err := CreateGithubIDPBuilder(ctx, state.Github)
-		if err != nil {
-			response.Diagnostics.AddError(err.Error(), err.Error())
+	details := state
+	if state.HTPasswd != nil {
+		// Write-only passwords are only available in the configuration
+		config := &IdentityProviderState{}
+		diags = request.Config.Get(ctx, config)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
 			return
 		}
-		builder.Github(githubBuilder)
-	case state.Google != nil:
-		builder.Type(cmv1.IdentityProviderTypeGoogle)
-		googleBuilder, err := CreateGoogleIDPBuilder(ctx, mappingMethod, state.Google)
-		if err != nil {
-			response.Diagnostics.AddError(err.Error(), err.Error())
-			return
-		}
-		builder.Google(googleBuilder)
-	case state.LDAP != nil:
-		builder.Type(cmv1.IdentityProviderTypeLDAP)
-		ldapBuilder, err := CreateLDAPIDPBuilder(ctx, state.LDAP)
-		if err != nil {
-			response.Diagnostics.AddError(err.Error(), err.Error())
-			return
-		}
-		builder.LDAP(ldapBuilder)
-	case state.OpenID != nil:
-		builder.Type(cmv1.IdentityProviderTypeOpenID)
-		openidBuilder, err := CreateOpenIDIDPBuilder(ctx, state.OpenID)
-		if err != nil {
-			response.Diagnostics.AddError(err.Error(), err.Error())
-			return
-		}
-		builder.OpenID(openidBuilder)
+		resolvedState := *state
+		resolvedState.HTPasswd = withWriteOnlyPasswords(state.HTPasswd, config.HTPasswd, nil)
+		details = &resolvedState
+	}
+	err = setIdentityProviderDetails(ctx, builder, details, mappingMethod)
+	if err != nil {
+		response.Diagnostics.AddError(err.Error(), err.Error())
+		return
 	}
 	object, err := builder.Build()
 	if err != nil {
@@ -304,26 +310,7 @@ func (r *IdentityProviderResource) Create(ctx context.Context, request resource.
 
 	state.ID = types.StringValue(object.ID())
 
-	state.MappingMethod = types.StringValue(string(object.MappingMethod()))
-	htpasswdObject := object.Htpasswd()
-	gitlabObject := object.Gitlab()
-	ldapObject := object.LDAP()
-	openidObject := object.OpenID()
-	switch {
-	case htpasswdObject != nil:
-		// Nothing, there are no computed attributes for `htpasswd` identity providers.
-	case gitlabObject != nil:
-		// Nothing, there are no computed attributes for `gitlab` identity providers.
-	case ldapObject != nil:
-		if state.LDAP == nil {
-			state.LDAP = &LDAPIdentityProvider{}
-		}
-		insecure, ok := ldapObject.GetInsecure()
-		if ok {
-			state.LDAP.Insecure = types.BoolValue(insecure)
-		}
-	case openidObject != nil:
-	}
+	populateComputedAttributes(state, object)
 
 	// Save the state:
 	diags = response.State.Set(ctx, state)
@@ -594,12 +581,6 @@ func (r *IdentityProviderResource) Update(ctx context.Context, request resource.
 		return
 	}
 
-	if state.HTPasswd == nil {
-		response.Diagnostics.AddError("IDP Update not supported for non-HTPasswd IDPs.",
-			"This RHCS provider version does not support updating an existing IDP")
-		return
-	}
-
 	// Get the plan:
 	plan := &IdentityProviderState{}
 	diags = request.Plan.Get(ctx, plan)
@@ -612,7 +593,66 @@ func (r *IdentityProviderResource) Update(ctx context.Context, request resource.
 	resource := r.collection.Cluster(state.Cluster.ValueString()).IdentityProviders().
 		IdentityProvider(state.ID.ValueString())
 
-	UpdateHTPasswd(ctx, resource, state, plan, response)
+	if state.HTPasswd != nil {
+		// Write-only passwords are only available in the configuration
+		config := &IdentityProviderState{}
+		diags = request.Config.Get(ctx, config)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		resolvedPlan := *plan
+		resolvedPlan.HTPasswd = withWriteOnlyPasswords(plan.HTPasswd, config.HTPasswd, state.HTPasswd)
+
+		UpdateHTPasswd(ctx, resource, state, &resolvedPlan, response)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The users of `htpasswd` identity providers are updated by the calls above, the details of
+	// the other types of identity providers are replaced as a whole.
+	mappingMethodChanged := !state.MappingMethod.Equal(plan.MappingMethod)
+	detailsChanged := plan.HTPasswd == nil && !reflect.DeepEqual(identityProviderDetails(state), identityProviderDetails(plan))
+	if mappingMethodChanged || detailsChanged {
+		mappingMethod := defaultMappingMethod
+		if common.HasValue(plan.MappingMethod) {
+			mappingMethod = plan.MappingMethod.ValueString()
+		}
+		builder := cmv1.NewIdentityProvider()
+		builder.MappingMethod(cmv1.IdentityProviderMappingMethod(mappingMethod))
+		if detailsChanged {
+			err := setIdentityProviderDetails(ctx, builder, plan, mappingMethod)
+			if err != nil {
+				response.Diagnostics.AddError(err.Error(), err.Error())
+				return
+			}
+		}
+		patch, err := builder.Build()
+		if err != nil {
+			response.Diagnostics.AddError(
+				"Can't build identity provider patch",
+				fmt.Sprintf(
+					"Can't build patch for identity provider with identifier '%s': %v",
+					state.ID.ValueString(), err,
+				),
+			)
+			return
+		}
+		update, err := resource.Update().Body(patch).SendContext(ctx)
+		if err != nil {
+			response.Diagnostics.AddError(
+				"Can't update identity provider",
+				fmt.Sprintf(
+					"Can't update identity provider with identifier '%s' for "+
+						"cluster '%s': %v",
+					state.ID.ValueString(), state.Cluster.ValueString(), err,
+				),
+			)
+			return
+		}
+		populateComputedAttributes(plan, update.Body())
+	}
 
 	diags = response.State.Set(ctx, plan)
 	response.Diagnostics.Append(diags...)
@@ -689,6 +729,107 @@ func (r *IdentityProviderResource) ImportState(ctx context.Context, request reso
 	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), providerID)...)
 }
 
+// setIdentityProviderDetails sets the type and the type specific details of the identity provider
+// described by the given state.
+func setIdentityProviderDetails(ctx context.Context, builder *cmv1.IdentityProviderBuilder,
+	state *IdentityProviderState, mappingMethod string) error {
+	switch {
+	case state.HTPasswd != nil:
+		builder.Type(cmv1.IdentityProviderTypeHtpasswd)
+		htpasswdBuilder, err := CreateHTPasswdIDPBuilder(ctx, state.HTPasswd)
+		if err != nil {
+			return err
+		}
+		builder.Htpasswd(htpasswdBuilder)
+	case state.Gitlab != nil:
+		builder.Type(cmv1.IdentityProviderTypeGitlab)
+		gitlabBuilder, err := CreateGitlabIDPBuilder(ctx, state.Gitlab)
+		if err != nil {
+			return err
+		}
+		builder.Gitlab(gitlabBuilder)
+	case state.Github != nil:
+		builder.Type(cmv1.IdentityProviderTypeGithub)
+		githubBuilder, err := CreateGithubIDPBuilder(ctx, state.Github)
+		if err != nil {
+			return err
+		}
+		builder.Github(githubBuilder)
+	case state.Google != nil:
+		builder.Type(cmv1.IdentityProviderTypeGoogle)
+		googleBuilder, err := CreateGoogleIDPBuilder(ctx, mappingMethod, state.Google)
+		if err != nil {
+			return err
+		}
+		builder.Google(googleBuilder)
+	case state.LDAP != nil:
+		builder.Type(cmv1.IdentityProviderTypeLDAP)
+		ldapBuilder, err := CreateLDAPIDPBuilder(ctx, state.LDAP)
+		if err != nil {
+			return err
+		}
+		builder.LDAP(ldapBuilder)
+	case state.OpenID != nil:
+		builder.Type(cmv1.IdentityProviderTypeOpenID)
+		openidBuilder, err := CreateOpenIDIDPBuilder(ctx, state.OpenID)
+		if err != nil {
+			return err
+		}
+		builder.OpenID(openidBuilder)
+	}
+	return nil
+}
+
+// identityProviderDetails returns the type specific details of the identity providers that are
+// updated as a whole.
+func identityProviderDetails(state *IdentityProviderState) []any {
+	return []any{state.Gitlab, state.Github, state.Google, state.LDAP, state.OpenID}
+}
+
+// populateComputedAttributes copies the attributes computed by the server into the state.
+func populateComputedAttributes(state *IdentityProviderState, object *cmv1.IdentityProvider) {
+	state.MappingMethod = types.StringValue(string(object.MappingMethod()))
+	ldapObject := object.LDAP()
+	if ldapObject == nil {
+		// Nothing, there are no computed attributes for the other types of identity providers.
+		return
+	}
+	if state.LDAP == nil {
+		state.LDAP = &LDAPIdentityProvider{}
+	}
+	insecure, ok := ldapObject.GetInsecure()
+	if ok {
+		state.LDAP.Insecure = types.BoolValue(insecure)
+	} else if state.LDAP.Insecure.IsUnknown() {
+		state.LDAP.Insecure = types.BoolNull()
+	}
+	if state.LDAP.Attributes == nil {
+		return
+	}
+	attributes := ldapObject.Attributes()
+	state.LDAP.Attributes.ID = computedListValue(state.LDAP.Attributes.ID, attributes.ID())
+	state.LDAP.Attributes.EMail = computedListValue(state.LDAP.Attributes.EMail, attributes.Email())
+	state.LDAP.Attributes.Name = computedListValue(state.LDAP.Attributes.Name, attributes.Name())
+	state.LDAP.Attributes.PreferredUsername = computedListValue(state.LDAP.Attributes.PreferredUsername,
+		attributes.PreferredUsername())
+}
+
+// computedListValue returns the value returned by the server for a computed list attribute that
+// is unknown in the plan.
+func computedListValue(current types.List, values []string) types.List {
+	if !current.IsUnknown() {
+		return current
+	}
+	if values == nil {
+		return types.ListNull(types.StringType)
+	}
+	list, err := common.StringArrayToList(values)
+	if err != nil {
+		return types.ListNull(types.StringType)
+	}
+	return list
+}
+
 // getIDPIDFromName returns the ID of the identity provider with the given name.
 func getIDPIDFromName(ctx context.Context, client *cmv1.ClusterClient, name string) (string, error) {
 	tflog.Debug(ctx, "Converting IDP name to ID", map[string]any{"name": name})
@@ -23,8 +23,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 )
 
 type OCMInfoDataSource struct {
@@ -77,7 +78,7 @@ func (d *OCMInfoDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				Computed:    true,
 			},
 			"ocm_api": schema.StringAttribute{
-				Description: "OCM API url",
+				Description: "OCM API url, as set by the `url` or the `environment` attribute of the provider",
 				Computed:    true,
 			},
 			"ocm_aws_account_id": schema.StringAttribute{
@@ -95,7 +96,7 @@ func (d *OCMInfoDataSource) Configure(ctx context.Context, req datasource.Config
 	}
 
 	// Cast the provider data to the specific implementation:
-	connection := req.ProviderData.(*sdk.Connection)
+	connection := req.ProviderData.(*common.ProviderData).Connection
 
 	// Get the collection of cloud providers:
 	d.collection = connection.AccountsMgmt().V1().CurrentAccount()
@@ -21,17 +21,20 @@ import (
 	"fmt"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/openshift-online/ocm-common/pkg/ocm/client"
-	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
@@ -70,7 +73,7 @@ func (k *KubeletConfigResource) Metadata(_ context.Context, request resource.Met
 	response.TypeName = request.ProviderTypeName + resourceTypeName
 }
 
-func (k *KubeletConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (k *KubeletConfigResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 
 	resp.Schema = schema.Schema{
 		Description: "KubeletConfig allows setting a customized Kubelet configuration",
@@ -100,6 +103,20 @@ func (k *KubeletConfigResource) Schema(_ context.Context, _ resource.SchemaReque
 				Computed:    true,
 				Description: "Name of the KubeletConfig." + common.ValueCannotBeChangedStringDescription,
 			},
+			"node_pools": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Identifiers of the machine pools that consume the KubeletConfig. Only populated " +
+					"for Hosted Control Plane clusters, where machine pools select KubeletConfigs with the " +
+					"'kubelet_configs' attribute of the 'rhcs_hcp_machine_pool' resource. On classic clusters " +
+					"the KubeletConfig applies to all machine pools.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true}),
 		},
 	}
 }
@@ -124,8 +141,12 @@ func (k *KubeletConfigResource) Create(ctx context.Context, req resource.CreateR
 		)
 		return
 	}
-	waitTimeoutInMinutes := int64(60)
-	if _, err := k.clusterWait.WaitForClusterToBeReady(ctx, clusterId, waitTimeoutInMinutes); err != nil {
+	waitTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultClusterReadyTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if _, err := k.clusterWait.WaitForClusterToBeReady(ctx, clusterId, common.DurationToMinutes(waitTimeout)); err != nil {
 		resp.Diagnostics.AddError(
 			"Cluster is not ready",
 			fmt.Sprintf("Cluster with id '%s' is not in the ready state: %v", clusterId, err),
@@ -162,6 +183,11 @@ func (k *KubeletConfigResource) Create(ctx context.Context, req resource.CreateR
 
 	plan.ID = types.StringValue(createdConfig.ID())
 	plan.Name = types.StringValue(createdConfig.Name())
+	// A KubeletConfig that was just created can't be consumed by any machine pool yet:
+	plan.NodePools = types.ListNull(types.StringType)
+	if isHCP {
+		plan.NodePools = types.ListValueMust(types.StringType, []attr.Value{})
+	}
 	k.writeStateToResponse(ctx, plan, &resp.State, &resp.Diagnostics)
 }
 
@@ -230,6 +256,13 @@ func (k *KubeletConfigResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	k.convertApiResourceToState(kubeletConfig, state)
+	state.NodePools, err = k.getNodePools(ctx, clusterId, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(failedToReadSummary,
+			fmt.Sprintf("Cannot find machine pools consuming KubeletConfig '%s' for cluster '%s': %v",
+				kubeletConfigId, clusterId, err))
+		return
+	}
 	k.writeStateToResponse(ctx, state, &resp.State, &resp.Diagnostics)
 }
 
@@ -294,6 +327,8 @@ func (k *KubeletConfigResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	k.convertApiResourceToState(updateResponse, plan)
+	// Updating the KubeletConfig doesn't change the machine pools that consume it, so the planned
+	// 'node_pools' are kept, and refreshed by Read.
 	k.writeStateToResponse(ctx, plan, &resp.State, &resp.Diagnostics)
 }
 
@@ -327,19 +362,21 @@ func (k *KubeletConfigResource) Configure(_ context.Context, req resource.Config
 		return
 	}
 
-	connection, ok := req.ProviderData.(*sdk.Connection)
+	providerData, ok := req.ProviderData.(*common.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.Connaction, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
+	connection := providerData.Connection
 
 	clusterCollection := connection.ClustersMgmt().V1().Clusters()
 	k.clusterClient = common.NewClusterClient(clusterCollection)
 	k.configsClient = client.NewKubeletConfigsClient(clusterCollection)
-	k.clusterWait = common.NewClusterWait(clusterCollection, connection)
+	k.clusterWait = common.NewClusterWait(clusterCollection, connection,
+		common.WithPollingInterval(providerData.ClusterWaitPollingInterval))
 }
 
 func isHCP(ctx context.Context, clusterId string, clusterClient common.ClusterClient) (bool, error) {
@@ -351,6 +388,32 @@ func isHCP(ctx context.Context, clusterId string, clusterClient common.ClusterCl
 	return cluster.Hypershift().Enabled(), nil
 }
 
+// getNodePools returns the identifiers of the node pools that reference the KubeletConfig with the
+// given name. KubeletConfigs of classic clusters apply to all the machine pools, so the result is null.
+func (k *KubeletConfigResource) getNodePools(ctx context.Context, clusterId string, name string) (types.List, error) {
+	cluster, err := k.clusterClient.FetchCluster(ctx, clusterId)
+	if err != nil {
+		return types.ListNull(types.StringType), err
+	}
+	if !cluster.Hypershift().Enabled() {
+		return types.ListNull(types.StringType), nil
+	}
+	nodePools, err := k.clusterClient.FetchNodePools(ctx, clusterId)
+	if err != nil {
+		return types.ListNull(types.StringType), err
+	}
+	ids := []attr.Value{}
+	for _, nodePool := range nodePools {
+		for _, kubeletConfig := range nodePool.KubeletConfigs() {
+			if kubeletConfig == name {
+				ids = append(ids, types.StringValue(nodePool.ID()))
+				break
+			}
+		}
+	}
+	return types.ListValueMust(types.StringType, ids), nil
+}
+
 func getKubeletConfigId(ctx context.Context, state *KubeletConfigState, clusterId string, name string,
 	configsClient client.KubeletConfigsClient) (string, error) {
 	id := ""
@@ -189,6 +189,7 @@ var _ = Describe("KubeletConfig Resource", func() {
 		var response *tfResources.ReadResponse
 		var state tfsdk.State
 		var kubeletConfig *cmv1.KubeletConfig
+		var classicCluster, hcpCluster *cmv1.Cluster
 		var err error
 
 		BeforeEach(func() {
@@ -196,6 +197,10 @@ var _ = Describe("KubeletConfig Resource", func() {
 			state = createState(ctx, resource)
 			kubeletConfig, err = createKubeletConfig(createPidsLimit)
 			Expect(err).NotTo(HaveOccurred())
+			classicCluster, err = createCluster(false)
+			Expect(err).NotTo(HaveOccurred())
+			hcpCluster, err = createCluster(true)
+			Expect(err).NotTo(HaveOccurred())
 
 			request = tfResources.ReadRequest{
 				State: state,
@@ -210,9 +215,49 @@ var _ = Describe("KubeletConfig Resource", func() {
 			configsClient.EXPECT().List(gomock.Eq(ctx), gomock.Eq(clusterId), gomock.Any()).
 				Return([]*cmv1.KubeletConfig{returnedKubeletConfig}, true, nil)
 			configsClient.EXPECT().Exists(ctx, clusterId, id).Return(true, kubeletConfig, nil)
+			clusterClient.EXPECT().FetchCluster(gomock.Any(), gomock.Eq(clusterId)).Return(classicCluster, nil)
+
+			resource.Read(ctx, request, response)
+			Expect(response.Diagnostics.ErrorsCount()).To(Equal(0))
+
+			var readState KubeletConfigState
+			Expect(response.State.Get(ctx, &readState).HasError()).To(BeFalse())
+			Expect(readState.NodePools.IsNull()).To(BeTrue())
+		})
+
+		It("Reads the machine pools consuming the KubeletConfig of a HCP cluster", func() {
+			configsClient.EXPECT().List(gomock.Eq(ctx), gomock.Eq(clusterId), gomock.Any()).
+				Return([]*cmv1.KubeletConfig{returnedKubeletConfig}, true, nil)
+			configsClient.EXPECT().Exists(ctx, clusterId, id).Return(true, returnedKubeletConfig, nil)
+			clusterClient.EXPECT().FetchCluster(gomock.Any(), gomock.Eq(clusterId)).Return(hcpCluster, nil)
+			nodePools, err := createNodePools(map[string][]string{
+				"np-1": {returnedKubeletConfig.Name()},
+				"np-2": {"other"},
+				"np-3": {},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			clusterClient.EXPECT().FetchNodePools(gomock.Any(), gomock.Eq(clusterId)).Return(nodePools, nil)
 
 			resource.Read(ctx, request, response)
 			Expect(response.Diagnostics.ErrorsCount()).To(Equal(0))
+
+			var readState KubeletConfigState
+			Expect(response.State.Get(ctx, &readState).HasError()).To(BeFalse())
+			var ids []string
+			Expect(readState.NodePools.ElementsAs(ctx, &ids, false).HasError()).To(BeFalse())
+			Expect(ids).To(Equal([]string{"np-1"}))
+		})
+
+		It("Fails to read the KubeletConfig if the machine pools cannot be listed", func() {
+			configsClient.EXPECT().List(gomock.Eq(ctx), gomock.Eq(clusterId), gomock.Any()).
+				Return([]*cmv1.KubeletConfig{returnedKubeletConfig}, true, nil)
+			configsClient.EXPECT().Exists(ctx, clusterId, id).Return(true, returnedKubeletConfig, nil)
+			clusterClient.EXPECT().FetchCluster(gomock.Any(), gomock.Eq(clusterId)).Return(hcpCluster, nil)
+			clusterClient.EXPECT().FetchNodePools(gomock.Any(), gomock.Eq(clusterId)).Return(
+				nil, fmt.Errorf("failed to list node pools"))
+
+			resource.Read(ctx, request, response)
+			Expect(response.Diagnostics.ErrorsCount()).To(Equal(1))
 		})
 
 		It("Fails to read the KubeletConfig if it does not exist", func() {
@@ -340,12 +385,17 @@ func createState(ctx context.Context, resource KubeletConfigResource) tfsdk.Stat
 	Expect(err).NotTo(HaveOccurred())
 	configName, err := types.StringUnknown().ToTerraformValue(ctx)
 	Expect(err).NotTo(HaveOccurred())
+	nodePools, err := types.ListUnknown(types.StringType).ToTerraformValue(ctx)
+	Expect(err).NotTo(HaveOccurred())
+	timeouts := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String}}, nil)
 
 	state := map[string]tftypes.Value{
 		"cluster":        cluster,
 		"pod_pids_limit": pids,
 		"id":             configId,
 		"name":           configName,
+		"node_pools":     nodePools,
+		"timeouts":       timeouts,
 	}
 
 	return tfsdk.State{
@@ -367,12 +417,17 @@ func createPlan(ctx context.Context, resource KubeletConfigResource, requiredPid
 	Expect(err).NotTo(HaveOccurred())
 	configName, err := types.StringUnknown().ToTerraformValue(ctx)
 	Expect(err).NotTo(HaveOccurred())
+	nodePools, err := types.ListUnknown(types.StringType).ToTerraformValue(ctx)
+	Expect(err).NotTo(HaveOccurred())
+	timeouts := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String}}, nil)
 
 	state := map[string]tftypes.Value{
 		"cluster":        cluster,
 		"pod_pids_limit": pids,
 		"id":             configId,
 		"name":           configName,
+		"node_pools":     nodePools,
+		"timeouts":       timeouts,
 	}
 
 	return tfsdk.Plan{
@@ -381,6 +436,22 @@ func createPlan(ctx context.Context, resource KubeletConfigResource, requiredPid
 	}
 }
 
+func createNodePools(kubeletConfigs map[string][]string) ([]*cmv1.NodePool, error) {
+	nodePools := []*cmv1.NodePool{}
+	for _, id := range []string{"np-1", "np-2", "np-3"} {
+		configs, ok := kubeletConfigs[id]
+		if !ok {
+			continue
+		}
+		nodePool, err := cmv1.NewNodePool().ID(id).KubeletConfigs(configs...).Build()
+		if err != nil {
+			return nil, err
+		}
+		nodePools = append(nodePools, nodePool)
+	}
+	return nodePools, nil
+}
+
 func createCluster(isHCP bool) (*cmv1.Cluster, error) {
 	return cmv1.NewCluster().ID(clusterId).Hypershift(cmv1.NewHypershift().Enabled(isHCP)).Build()
 }
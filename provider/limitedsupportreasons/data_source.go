@@ -0,0 +1,126 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package limitedsupportreasons
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+type LimitedSupportReasonsDataSource struct {
+	collection *cmv1.ClustersClient
+}
+
+var _ datasource.DataSource = &LimitedSupportReasonsDataSource{}
+var _ datasource.DataSourceWithConfigure = &LimitedSupportReasonsDataSource{}
+
+func New() datasource.DataSource {
+	return &LimitedSupportReasonsDataSource{}
+}
+
+func (d *LimitedSupportReasonsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_limited_support_reasons"
+}
+
+func (d *LimitedSupportReasonsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reasons why a cluster is in limited support. The list is empty when the cluster is fully supported.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Identifier of the cluster.",
+				Required:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "Active limited support reasons of the cluster. Reasons that have been overridden " +
+					"aren't included.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Unique identifier of the reason.",
+							Computed:    true,
+						},
+						"summary": schema.StringAttribute{
+							Description: "Summary of the reason.",
+							Computed:    true,
+						},
+						"details": schema.StringAttribute{
+							Description: "Details of the reason, usually with the steps needed to restore full support.",
+							Computed:    true,
+						},
+						"detection_type": schema.StringAttribute{
+							Description: "How the reason was detected, either 'auto' or 'manual'.",
+							Computed:    true,
+						},
+						"creation_timestamp": schema.StringAttribute{
+							Description: "Date and time when the reason was added, in RFC3339 format.",
+							Computed:    true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *LimitedSupportReasonsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured:
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Cast the provider data to the specific implementation:
+	connection := req.ProviderData.(*common.ProviderData).Connection
+
+	// Get the collection of clusters:
+	d.collection = connection.ClustersMgmt().V1().Clusters()
+}
+
+func (d *LimitedSupportReasonsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the state:
+	state := &LimitedSupportReasonsState{}
+	diags := req.Config.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterId := state.Cluster.ValueString()
+	reasons, err := common.ActiveLimitedSupportReasons(ctx, d.collection.Cluster(clusterId).LimitedSupportReasons())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't get limited support reasons",
+			fmt.Sprintf("Can't get limited support reasons of cluster '%s': %v", clusterId, err),
+		)
+		return
+	}
+
+	// Populate the state:
+	state.Items = make([]*LimitedSupportReasonState, len(reasons))
+	for i, reason := range reasons {
+		creationTimestamp := types.StringNull()
+		if !reason.CreationTimestamp().IsZero() {
+			creationTimestamp = types.StringValue(reason.CreationTimestamp().Format(time.RFC3339))
+		}
+		state.Items[i] = &LimitedSupportReasonState{
+			ID:                types.StringValue(reason.ID()),
+			Summary:           types.StringValue(reason.Summary()),
+			Details:           types.StringValue(reason.Details()),
+			DetectionType:     types.StringValue(string(reason.DetectionType())),
+			CreationTimestamp: creationTimestamp,
+		}
+	}
+
+	// Save the state:
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,19 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package limitedsupportreasons
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type LimitedSupportReasonsState struct {
+	Cluster types.String                 `tfsdk:"cluster"`
+	Items   []*LimitedSupportReasonState `tfsdk:"items"`
+}
+
+type LimitedSupportReasonState struct {
+	ID                types.String `tfsdk:"id"`
+	Summary           types.String `tfsdk:"summary"`
+	Details           types.String `tfsdk:"details"`
+	DetectionType     types.String `tfsdk:"detection_type"`
+	CreationTimestamp types.String `tfsdk:"creation_timestamp"`
+}
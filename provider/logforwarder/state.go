@@ -3,7 +3,10 @@
 
 package logforwarder
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 // LogForwarder is used by the resource
 type LogForwarder struct {
@@ -13,6 +16,8 @@ type LogForwarder struct {
 	CloudWatch   types.Object `tfsdk:"cloudwatch"`
 	Applications types.List   `tfsdk:"applications"`
 	Groups       types.List   `tfsdk:"groups"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // LogForwardersState is used by the data source
@@ -26,8 +26,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 )
 
 type MachinePoolDatasource struct {
@@ -50,14 +51,15 @@ func (r *MachinePoolDatasource) Configure(ctx context.Context, req datasource.Co
 		return
 	}
 
-	connection, ok := req.ProviderData.(*sdk.Connection)
+	providerData, ok := req.ProviderData.(*common.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.Connaction, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
+	connection := providerData.Connection
 
 	r.collection = connection.ClustersMgmt().V1().Clusters()
 }
@@ -165,6 +167,16 @@ func (r *MachinePoolDatasource) Schema(ctx context.Context, req datasource.Schem
 				ElementType: types.StringType,
 				Computed:    true,
 			},
+			"aws_tags": schema.MapAttribute{
+				Description: "User defined tags of the machine pool resources created in AWS.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"aws_tags_all": schema.MapAttribute{
+				Description: "All the tags applied to the machine pool resources created in AWS, including the default tags of the provider.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			"ignore_deletion_error": schema.BoolAttribute{
 				Description: "Indicates to the provider to disregard API errors when deleting the machine pool." +
 					" This will remove the resource from the management file, but not necessirely delete the underlying pool in case it errors." +
@@ -172,6 +184,7 @@ func (r *MachinePoolDatasource) Schema(ctx context.Context, req datasource.Schem
 					" This is not recommended to be set in other use cases",
 				Computed: true,
 			},
+			"timeouts": common.DataSourceTimeoutsAttribute(timeoutsOpts, "This attribute is not supported for machine pool data source."),
 		},
 	}
 }
@@ -26,6 +26,7 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
@@ -45,10 +46,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	diskValidator "github.com/openshift-online/ocm-common/pkg/machinepool/validations"
-	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common/planmodifiers"
 )
 
 // This is a magic name to trigger special handling for the cluster's default
@@ -62,11 +63,15 @@ var machinepoolNameRE = regexp.MustCompile(
 type MachinePoolResource struct {
 	clusterCollection *cmv1.ClustersClient
 	clusterWait       common.ClusterWait
+	defaultTags       map[string]string
 }
 
 var _ resource.ResourceWithConfigure = &MachinePoolResource{}
 var _ resource.ResourceWithImportState = &MachinePoolResource{}
 var _ resource.ResourceWithConfigValidators = &MachinePoolResource{}
+var _ resource.ResourceWithModifyPlan = &MachinePoolResource{}
+
+var timeoutsOpts = timeouts.Opts{Create: true}
 
 func New() resource.Resource {
 	return &MachinePoolResource{}
@@ -220,6 +225,11 @@ func (r *MachinePoolResource) Schema(ctx context.Context, req resource.SchemaReq
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"aws_tags_all": schema.MapAttribute{
+				Description: "All the tags applied to the machine pool resources created in AWS, including the default tags of the provider.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			"ignore_deletion_error": schema.BoolAttribute{
 				Description: "Indicates to the provider to disregard API errors when deleting the machine pool." +
 					" This will remove the resource from the management file, but not necessirely delete the underlying pool in case it errors." +
@@ -230,6 +240,9 @@ func (r *MachinePoolResource) Schema(ctx context.Context, req resource.SchemaReq
 				Default:  booldefault.StaticBool(false),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeoutsOpts),
+		},
 	}
 }
 
@@ -247,17 +260,28 @@ func (r *MachinePoolResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	connection, ok := req.ProviderData.(*sdk.Connection)
+	providerData, ok := req.ProviderData.(*common.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.Connaction, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
+	connection := providerData.Connection
 
 	r.clusterCollection = connection.ClustersMgmt().V1().Clusters()
-	r.clusterWait = common.NewClusterWait(r.clusterCollection, connection)
+	r.clusterWait = common.NewClusterWait(r.clusterCollection, connection,
+		common.WithPollingInterval(providerData.ClusterWaitPollingInterval))
+	r.defaultTags = providerData.DefaultTags
+}
+
+func (r *MachinePoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The default tags come from the configuration of the provider, so wait till it is configured:
+	if r.clusterCollection == nil {
+		return
+	}
+	planmodifiers.MergeDefaultTags(ctx, req, resp, path.Root("aws_tags"), path.Root("aws_tags_all"), r.defaultTags)
 }
 
 func (r *MachinePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -281,8 +305,12 @@ func (r *MachinePoolResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Wait till the cluster is ready:
-	waitTimeoutInMinutes := int64(60)
-	cluster, err := r.clusterWait.WaitForClusterToBeReady(ctx, plan.Cluster.ValueString(), waitTimeoutInMinutes)
+	waitTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultClusterReadyTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cluster, err := r.clusterWait.WaitForClusterToBeReady(ctx, plan.Cluster.ValueString(), common.DurationToMinutes(waitTimeout))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Cannot poll cluster state",
@@ -383,11 +411,11 @@ func (r *MachinePoolResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		awsMachinePoolBuilder.AdditionalSecurityGroupIds(additionalSecurityGroupIds...)
 	}
-	if common.HasValue(plan.AwsTags) {
+	if common.HasValue(plan.AwsTagsAll) {
 		if awsMachinePoolBuilder == nil {
 			awsMachinePoolBuilder = cmv1.NewAWSMachinePool()
 		}
-		awsTags, err := common.OptionalMap(ctx, plan.AwsTags)
+		awsTags, err := common.OptionalMap(ctx, plan.AwsTagsAll)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Cannot convert AWS tags map object to string map",
@@ -507,9 +535,10 @@ func (r *MachinePoolResource) Create(ctx context.Context, req resource.CreateReq
 func (r *MachinePoolResource) magicImport(ctx context.Context, plan *MachinePoolState, resp *resource.CreateResponse) {
 	machinepoolName := plan.Name.ValueString()
 	state := &MachinePoolState{
-		ID:      types.StringValue(machinepoolName),
-		Cluster: plan.Cluster,
-		Name:    types.StringValue(machinepoolName),
+		ID:       types.StringValue(machinepoolName),
+		Cluster:  plan.Cluster,
+		Name:     types.StringValue(machinepoolName),
+		Timeouts: plan.Timeouts,
 	}
 	plan.ID = types.StringValue(machinepoolName)
 	adjustInitialStateToPlan(state, plan)
@@ -847,6 +876,7 @@ func adjustInitialStateToPlan(state, plan *MachinePoolState) {
 	if common.HasValue(plan.AwsTags) {
 		state.AwsTags = plan.AwsTags
 	}
+	state.AwsTagsAll = plan.AwsTagsAll
 }
 
 // Validate the machine pool's settings that pertain to availability zones.
@@ -1177,19 +1207,26 @@ func filterClusterTagsNotPresentInNpInput(ctx context.Context, state *MachinePoo
 	if len(awsTags) == 0 {
 		return awsTags, nil
 	}
-	if cluster.AWS() == nil || len(cluster.AWS().Tags()) == 0 {
-		return awsTags, nil
-	}
 	filteredTags := make(map[string]string, len(awsTags))
 	maps.Copy(filteredTags, awsTags)
 	currentNpTfTags, err := common.OptionalMap(ctx, state.AwsTags)
 	if err != nil {
 		return filteredTags, err
 	}
-	clusterTags := cluster.AWS().Tags()
-	for k := range clusterTags {
-		if _, ok := currentNpTfTags[k]; !ok {
-			delete(filteredTags, k)
+	// The default tags of the provider aren't part of the tags given by the user either:
+	allNpTfTags, err := common.OptionalMap(ctx, state.AwsTagsAll)
+	if err != nil {
+		return filteredTags, err
+	}
+	var clusterTags map[string]string
+	if cluster.AWS() != nil {
+		clusterTags = cluster.AWS().Tags()
+	}
+	for _, tags := range []map[string]string{clusterTags, allNpTfTags} {
+		for k := range tags {
+			if _, ok := currentNpTfTags[k]; !ok {
+				delete(filteredTags, k)
+			}
 		}
 	}
 	return filteredTags, nil
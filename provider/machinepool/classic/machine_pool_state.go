@@ -17,6 +17,7 @@ limitations under the License.
 package classic
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -41,7 +42,10 @@ type MachinePoolState struct {
 	DiskSize                   types.Int64   `tfsdk:"disk_size"`
 	AdditionalSecurityGroupIds types.List    `tfsdk:"aws_additional_security_group_ids"`
 	AwsTags                    types.Map     `tfsdk:"aws_tags"`
+	AwsTagsAll                 types.Map     `tfsdk:"aws_tags_all"`
 	IgnoreDeletionError        types.Bool    `tfsdk:"ignore_deletion_error"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type Taints struct {
@@ -29,6 +29,7 @@ type AWSNodePool struct {
 	InstanceType                  types.String  `tfsdk:"instance_type"`
 	InstanceProfile               types.String  `tfsdk:"instance_profile"`
 	Tags                          types.Map     `tfsdk:"tags"`
+	TagsAll                       types.Map     `tfsdk:"tags_all"`
 	AdditionalSecurityGroupIds    types.List    `tfsdk:"additional_security_group_ids"`
 	Ec2MetadataHttpTokens         types.String  `tfsdk:"ec2_metadata_http_tokens"`
 	DiskSize                      types.Int64   `tfsdk:"disk_size"`
@@ -61,6 +62,11 @@ func AwsNodePoolResource() map[string]schema.Attribute {
 			ElementType: types.StringType,
 			Optional:    true,
 		},
+		"tags_all": schema.MapAttribute{
+			Description: "All the tags applied to the machine pool resources created in AWS, including the default tags of the provider.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
 		"additional_security_group_ids": schema.ListAttribute{
 			Description: "Additional security group ids. " + common.ValueCannotBeChangedStringDescription,
 			ElementType: types.StringType,
@@ -183,6 +189,11 @@ func AwsNodePoolDatasource() map[string]dsschema.Attribute {
 			ElementType: types.StringType,
 			Optional:    true,
 		},
+		"tags_all": schema.MapAttribute{
+			Description: "All the tags applied to the machine pool resources created in AWS, including the default tags of the provider.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
 		"additional_security_group_ids": schema.ListAttribute{
 			Description: "Additional security group ids. " + common.ValueCannotBeChangedStringDescription,
 			ElementType: types.StringType,
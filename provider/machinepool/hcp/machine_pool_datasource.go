@@ -27,7 +27,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
@@ -53,14 +52,15 @@ func (r *HcpMachinePoolDatasource) Configure(ctx context.Context, req datasource
 		return
 	}
 
-	connection, ok := req.ProviderData.(*sdk.Connection)
+	providerData, ok := req.ProviderData.(*common.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.Connaction, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
+	connection := providerData.Connection
 
 	r.collection = connection.ClustersMgmt().V1().Clusters()
 }
@@ -173,6 +173,10 @@ func (r *HcpMachinePoolDatasource) Schema(ctx context.Context, req datasource.Sc
 					"upgrade to OpenShift 4.12.z from 4.11 or before).",
 				Computed: true,
 			},
+			"wait_for_upgrade_complete": schema.BoolAttribute{
+				Description: "This attribute is not supported for machine pool data source.",
+				Computed:    true,
+			},
 			"ignore_deletion_error": schema.BoolAttribute{
 				Description: "Indicates to the provider to disregard API errors when deleting the machine pool." +
 					" This will remove the resource from the management file, but not necessirely delete the underlying pool in case it errors." +
@@ -180,6 +184,7 @@ func (r *HcpMachinePoolDatasource) Schema(ctx context.Context, req datasource.Sc
 					" This is not recommended to be set in other use cases",
 				Computed: true,
 			},
+			"timeouts": common.DataSourceTimeoutsAttribute(timeoutsOpts, "This attribute is not supported for machine pool data source."),
 		},
 	}
 }
@@ -213,6 +218,7 @@ func (r *HcpMachinePoolDatasource) Read(ctx context.Context, req datasource.Read
 	state.UpgradeAcksFor = types.StringNull()
 	state.Version = types.StringNull()
 	state.IgnoreDeletionError = types.BoolNull()
+	state.WaitForUpgradeComplete = types.BoolNull()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
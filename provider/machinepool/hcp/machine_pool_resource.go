@@ -28,6 +28,7 @@ import (
 	"time"
 
 	semver "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
@@ -45,11 +46,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	diskValidator "github.com/openshift-online/ocm-common/pkg/machinepool/validations"
 	ocmUtils "github.com/openshift-online/ocm-common/pkg/ocm/utils"
-	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
 	rosa "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common/planmodifiers"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/machinepool/hcp/upgrade"
 )
 
@@ -68,11 +69,16 @@ type HcpMachinePoolResource struct {
 	clusterCollection *cmv1.ClustersClient
 	versionCollection *cmv1.VersionsClient
 	clusterWait       common.ClusterWait
+	pollingInterval   time.Duration
+	defaultTags       map[string]string
 }
 
 var _ resource.ResourceWithConfigure = &HcpMachinePoolResource{}
 var _ resource.ResourceWithImportState = &HcpMachinePoolResource{}
 var _ resource.ResourceWithConfigValidators = &HcpMachinePoolResource{}
+var _ resource.ResourceWithModifyPlan = &HcpMachinePoolResource{}
+
+var timeoutsOpts = timeouts.Opts{Create: true, Update: true}
 
 func New() resource.Resource {
 	return &HcpMachinePoolResource{}
@@ -203,6 +209,7 @@ func (r *HcpMachinePoolResource) Schema(ctx context.Context, req resource.Schema
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					planmodifiers.UnknownWhenWaitingForUpgrade("version", "wait_for_upgrade_complete"),
 				},
 			},
 			"upgrade_acknowledgements_for": schema.StringAttribute{
@@ -211,6 +218,12 @@ func (r *HcpMachinePoolResource) Schema(ctx context.Context, req resource.Schema
 					"upgrade to OpenShift 4.12.z from 4.11 or before).",
 				Optional: true,
 			},
+			"wait_for_upgrade_complete": schema.BoolAttribute{
+				Description: "Wait until the upgrade scheduled by a change of `version` is completed or has failed. The waiter " +
+					"uses the `update` timeout of the `timeouts` block, which defaults to 4 hours, with the default value " +
+					"set to false.",
+				Optional: true,
+			},
 			"ignore_deletion_error": schema.BoolAttribute{
 				Description: "Indicates to the provider to disregard API errors when deleting the machine pool." +
 					" This will remove the resource from the management file, but not necessirely delete the underlying pool in case it errors." +
@@ -221,6 +234,9 @@ func (r *HcpMachinePoolResource) Schema(ctx context.Context, req resource.Schema
 				Default:  booldefault.StaticBool(false),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeoutsOpts),
+		},
 	}
 }
 
@@ -237,18 +253,32 @@ func (r *HcpMachinePoolResource) Configure(ctx context.Context, req resource.Con
 		return
 	}
 
-	connection, ok := req.ProviderData.(*sdk.Connection)
+	providerData, ok := req.ProviderData.(*common.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.Connaction, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
+	connection := providerData.Connection
 
 	r.clusterCollection = connection.ClustersMgmt().V1().Clusters()
 	r.versionCollection = connection.ClustersMgmt().V1().Versions()
-	r.clusterWait = common.NewClusterWait(r.clusterCollection, connection)
+	r.clusterWait = common.NewClusterWait(r.clusterCollection, connection,
+		common.WithPollingInterval(providerData.ClusterWaitPollingInterval))
+	r.pollingInterval = providerData.ClusterWaitPollingInterval
+	r.defaultTags = providerData.DefaultTags
+}
+
+func (r *HcpMachinePoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The default tags come from the configuration of the provider, so wait till it is configured:
+	if r.clusterCollection == nil {
+		return
+	}
+	awsNodePool := path.Root("aws_node_pool")
+	planmodifiers.MergeDefaultTags(ctx, req, resp, awsNodePool.AtName("tags"), awsNodePool.AtName("tags_all"),
+		r.defaultTags)
 }
 
 func (r *HcpMachinePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -272,7 +302,12 @@ func (r *HcpMachinePoolResource) Create(ctx context.Context, req resource.Create
 	}
 
 	// Wait till the cluster is ready:
-	clusterObject, err := r.clusterWait.WaitForClusterToBeReady(ctx, plan.Cluster.ValueString(), 60)
+	waitTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultClusterReadyTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clusterObject, err := r.clusterWait.WaitForClusterToBeReady(ctx, plan.Cluster.ValueString(), common.DurationToMinutes(waitTimeout))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Cannot poll cluster state",
@@ -298,7 +333,7 @@ func (r *HcpMachinePoolResource) Create(ctx context.Context, req resource.Create
 	if plan.AWSNodePool != nil {
 		awsNodePoolBuilder := cmv1.NewAWSNodePool()
 		awsNodePoolBuilder.InstanceType(plan.AWSNodePool.InstanceType.ValueString())
-		awsTags, err := common.OptionalMap(ctx, plan.AWSNodePool.Tags)
+		awsTags, err := common.OptionalMap(ctx, plan.AWSNodePool.TagsAll)
 		if err != nil {
 			return
 		}
@@ -567,9 +602,10 @@ func (r *HcpMachinePoolResource) Create(ctx context.Context, req resource.Create
 func (r *HcpMachinePoolResource) magicImport(ctx context.Context, plan *HcpMachinePoolState, resp *resource.CreateResponse) {
 	nodePoolName := plan.Name.ValueString()
 	state := &HcpMachinePoolState{
-		ID:      types.StringValue(nodePoolName),
-		Cluster: plan.Cluster,
-		Name:    types.StringValue(nodePoolName),
+		ID:       types.StringValue(nodePoolName),
+		Cluster:  plan.Cluster,
+		Name:     types.StringValue(nodePoolName),
+		Timeouts: plan.Timeouts,
 	}
 	plan.ID = types.StringValue(nodePoolName)
 	adjustInitialStateToPlan(state, plan)
@@ -1027,6 +1063,7 @@ func adjustInitialStateToPlan(state, plan *HcpMachinePoolState) {
 	if common.HasValue(plan.AWSNodePool.Tags) {
 		state.AWSNodePool.Tags = plan.AWSNodePool.Tags
 	}
+	state.AWSNodePool.TagsAll = plan.AWSNodePool.TagsAll
 
 	if common.HasValue(plan.TuningConfigs) {
 		state.TuningConfigs = plan.TuningConfigs
@@ -1110,6 +1147,25 @@ func (r *HcpMachinePoolResource) upgradeMachinePoolIfNeeded(ctx context.Context,
 		}
 	}
 
+	// Wait for the upgrade to finish if requested
+	if !cancelingUpgradeOnly && common.BoolWithFalseDefault(plan.WaitForUpgradeComplete) {
+		upgradeTimeout, diags := plan.Timeouts.Update(ctx, common.DefaultUpgradeTimeout)
+		if diags.HasError() {
+			return fmt.Errorf("failed to get the update timeout: %v", diags.Errors())
+		}
+		err = common.WaitForUpgrade(ctx,
+			fmt.Sprintf("machine pool '%s' of cluster '%s'", state.ID.ValueString(), state.Cluster.ValueString()),
+			upgradeTimeout,
+			r.pollingInterval,
+			func(ctx context.Context) (*common.UpgradeProgress, error) {
+				return upgrade.GetUpgradeProgress(ctx, r.clusterCollection,
+					state.Cluster.ValueString(), state.ID.ValueString(), desiredVersion)
+			})
+		if err != nil {
+			return err
+		}
+	}
+
 	state.Version = plan.Version
 	state.UpgradeAcksFor = plan.UpgradeAcksFor
 	return nil
@@ -1168,6 +1224,21 @@ func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient,
 		}
 	}
 	targetMinorVersion := getOcmVersionMinor(desiredVersion.String())
+	agreed := map[string]bool{}
+	if userAckRequired && userAckString != targetMinorVersion {
+		// Gates that were acknowledged beforehand, for example with the
+		// 'rhcs_version_gate_agreement' resource, don't need the user ack
+		agreed, err = common.AgreedVersionGates(ctx, clusterClient.GateAgreements())
+		if err != nil {
+			return err
+		}
+		userAckRequired = false
+		for _, gate := range gates {
+			if !gate.STSOnly() && !agreed[gate.ID()] {
+				userAckRequired = true
+			}
+		}
+	}
 	if userAckRequired && userAckString != targetMinorVersion { // User has not acknowledged mandatory gates, stop here.
 		return fmt.Errorf("%s\nTo acknowledge these items, please add \"upgrade_acknowledgements_for = %s\""+
 			" and re-apply the changes", description, targetMinorVersion)
@@ -1176,9 +1247,12 @@ func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient,
 	// Ack all gates to OCM
 	for _, gate := range gates {
 		gateID := gate.ID()
+		if agreed[gateID] {
+			continue
+		}
 		tflog.Debug(ctx, "Acknowledging version gate", map[string]any{"gateID": gateID})
 		gateAgreementsClient := clusterClient.GateAgreements()
-		err := upgrade.AckVersionGate(gateAgreementsClient, gateID)
+		_, err := upgrade.AckVersionGate(gateAgreementsClient, gateID)
 		if err != nil {
 			return fmt.Errorf("failed to acknowledge version gate '%s' for cluster '%s': %v",
 				gateID, clusterID, err)
@@ -1356,6 +1430,9 @@ func populateState(ctx context.Context, object *cmv1.NodePool, state *HcpMachine
 		if state.AWSNodePool.Tags.IsUnknown() || state.AWSNodePool.Tags.IsNull() {
 			state.AWSNodePool.Tags = types.MapNull(types.StringType)
 		}
+		if state.AWSNodePool.TagsAll.IsUnknown() || state.AWSNodePool.TagsAll.IsNull() {
+			state.AWSNodePool.TagsAll = types.MapNull(types.StringType)
+		}
 		if awsTags, ok := awsNodePool.GetTags(); ok {
 			filteredAwsTags, err := filterClusterTagsNotPresentInNpInput(ctx, state, cluster, awsTags)
 			if err != nil {
@@ -1551,19 +1628,26 @@ func filterClusterTagsNotPresentInNpInput(ctx context.Context, state *HcpMachine
 	if len(awsTags) == 0 {
 		return awsTags, nil
 	}
-	if cluster.AWS() == nil || len(cluster.AWS().Tags()) == 0 {
-		return awsTags, nil
-	}
 	filteredTags := make(map[string]string, len(awsTags))
 	maps.Copy(filteredTags, awsTags)
 	currentNpTfTags, err := common.OptionalMap(ctx, state.AWSNodePool.Tags)
 	if err != nil {
 		return filteredTags, err
 	}
-	clusterTags := cluster.AWS().Tags()
-	for k := range clusterTags {
-		if _, ok := currentNpTfTags[k]; !ok {
-			delete(filteredTags, k)
+	// The default tags of the provider aren't part of the tags given by the user either:
+	allNpTfTags, err := common.OptionalMap(ctx, state.AWSNodePool.TagsAll)
+	if err != nil {
+		return filteredTags, err
+	}
+	var clusterTags map[string]string
+	if cluster.AWS() != nil {
+		clusterTags = cluster.AWS().Tags()
+	}
+	for _, tags := range []map[string]string{clusterTags, allNpTfTags} {
+		for k := range tags {
+			if _, ok := currentNpTfTags[k]; !ok {
+				delete(filteredTags, k)
+			}
 		}
 	}
 	return filteredTags, nil
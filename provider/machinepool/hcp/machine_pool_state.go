@@ -17,6 +17,7 @@ limitations under the License.
 package hcp
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -35,7 +36,8 @@ type HcpMachinePoolState struct {
 	Version        types.String `tfsdk:"version"`
 	CurrentVersion types.String `tfsdk:"current_version"`
 
-	UpgradeAcksFor types.String `tfsdk:"upgrade_acknowledgements_for"`
+	UpgradeAcksFor         types.String `tfsdk:"upgrade_acknowledgements_for"`
+	WaitForUpgradeComplete types.Bool   `tfsdk:"wait_for_upgrade_complete"`
 
 	NodePoolStatus types.Object `tfsdk:"status"`
 	AWSNodePool    *AWSNodePool `tfsdk:"aws_node_pool"`
@@ -44,6 +46,8 @@ type HcpMachinePoolState struct {
 	AutoRepair     types.Bool   `tfsdk:"auto_repair"`
 
 	IgnoreDeletionError types.Bool `tfsdk:"ignore_deletion_error"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type Taints struct {
@@ -19,6 +19,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	semver "github.com/hashicorp/go-version"
@@ -161,20 +162,64 @@ func CheckAndCancelUpgrades(
 	return correctUpgradePending, nil
 }
 
+// Get the progress of the upgrade of the node pool to the given version. The
+// upgrade policy is removed once the upgrade finishes, so when there is no
+// policy for the version the current version of the node pool is checked
+func GetUpgradeProgress(ctx context.Context, client *cmv1.ClustersClient, clusterId string,
+	machinePoolId string, desiredVersion *semver.Version) (*common.UpgradeProgress, error) {
+	upgrades, err := GetScheduledUpgrades(ctx, client, clusterId, machinePoolId)
+	if err != nil {
+		return nil, err
+	}
+	for _, upgrade := range upgrades {
+		if upgrade.Policy.ScheduleType() == cmv1.ScheduleTypeAutomatic || upgrade.Policy.Version() == "" {
+			continue
+		}
+		toVersion, err := semver.NewVersion(upgrade.Policy.Version())
+		if err != nil || !desiredVersion.Equal(toVersion) {
+			continue
+		}
+		return &common.UpgradeProgress{
+			Completed:   upgrade.PolicyState.Value() == cmv1.UpgradePolicyStateValueCompleted,
+			State:       upgrade.PolicyState.Value(),
+			Description: upgrade.PolicyState.Description(),
+		}, nil
+	}
+	resp, err := client.Cluster(clusterId).NodePools().NodePool(machinePoolId).Get().SendContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get machine pool: %v", err)
+	}
+	// The identifier of the version of node pools may have the channel group
+	// appended, so only the core of the version is compared
+	version := resp.Body().Version()
+	rawId := version.RawID()
+	if rawId == "" {
+		rawId = strings.TrimPrefix(version.ID(), "openshift-v")
+	}
+	currentVersion, err := semver.NewVersion(rawId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse current machine pool version: %v", err)
+	}
+	return &common.UpgradeProgress{
+		Completed: !currentVersion.Core().LessThan(desiredVersion.Core()),
+	}, nil
+}
+
+// Acknowledge the given version gate, returning the created agreement
 func AckVersionGate(
 	gateAgreementsClient *cmv1.VersionGateAgreementsClient,
-	gateID string) error {
+	gateID string) (*cmv1.VersionGateAgreement, error) {
 	agreement, err := cmv1.NewVersionGateAgreement().
 		VersionGate(cmv1.NewVersionGate().ID(gateID)).
 		Build()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	response, err := gateAgreementsClient.Add().Body(agreement).Send()
 	if err != nil {
-		return common.HandleErr(response.Error(), err)
+		return nil, common.HandleErr(response.Error(), err)
 	}
-	return nil
+	return response.Body(), nil
 }
 
 // Construct a list of missing gate agreements for upgrade to a given cluster version
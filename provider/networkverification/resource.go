@@ -0,0 +1,315 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package networkverification
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+var platforms = []string{
+	string(cmv1.PlatformAwsClassic),
+	string(cmv1.PlatformAwsHostedCp),
+}
+
+// SubnetResult is the result of the verification of one subnet.
+type SubnetResult struct {
+	SubnetID types.String `tfsdk:"subnet_id"`
+	State    types.String `tfsdk:"state"`
+	Details  types.List   `tfsdk:"details"`
+}
+
+var subnetResultType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"subnet_id": types.StringType,
+		"state":     types.StringType,
+		"details":   types.ListType{ElemType: types.StringType},
+	},
+}
+
+type NetworkVerificationResource struct {
+	collection      *cmv1.NetworkVerificationsClient
+	pollingInterval time.Duration
+}
+
+var _ resource.Resource = &NetworkVerificationResource{}
+var _ resource.ResourceWithConfigure = &NetworkVerificationResource{}
+
+func New() resource.Resource {
+	return &NetworkVerificationResource{}
+}
+
+func (r *NetworkVerificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_verification"
+}
+
+func (r *NetworkVerificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Verifies that the given subnets have the network egress needed to install a cluster, and " +
+			"fails with the checks that didn't pass. The verification runs when the resource is created, or " +
+			"replaced. Use the `subnet_ids` attribute of this resource as the `aws_subnet_ids` of the cluster so " +
+			"that the cluster is only created after the verification passed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the verification, the identifiers of the subnets separated by commas.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subnet_ids": schema.ListAttribute{
+				Description: "Identifiers of the AWS subnets to verify. " + common.ValueCannotBeChangedStringDescription,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`),
+						"subnet ID may not be empty/blank string")),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"cloud_region": schema.StringAttribute{
+				Description: "AWS region of the subnets, for example 'us-east-1'. " +
+					common.ValueCannotBeChangedStringDescription,
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_arn": schema.StringAttribute{
+				Description: "ARN of the installer role, that the verification assumes to run in the AWS account " +
+					"of the subnets. " + common.ValueCannotBeChangedStringDescription,
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"platform": schema.StringAttribute{
+				Description: fmt.Sprintf("Platform of the cluster that will use the subnets, either '%s' or '%s'. "+
+					"The egress needed by hosted control plane clusters is different. Defaults to '%s'. ",
+					cmv1.PlatformAwsClassic, cmv1.PlatformAwsHostedCp, cmv1.PlatformAwsClassic) +
+					common.ValueCannotBeChangedStringDescription,
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(platforms...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.MapAttribute{
+				Description: "AWS tags added to the resources created by the verification. " +
+					common.ValueCannotBeChangedStringDescription,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "Result of the verification of each subnet, in the same order as `subnet_ids`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"subnet_id": schema.StringAttribute{
+							Description: "Identifier of the subnet.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "State of the verification of the subnet, either 'passed' or 'failed'.",
+							Computed:    true,
+						},
+						"details": schema.ListAttribute{
+							Description: "Checks that failed.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+				Computed: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true}),
+		},
+	}
+}
+
+func (r *NetworkVerificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*common.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	connection := providerData.Connection
+
+	r.collection = connection.ClustersMgmt().V1().NetworkVerifications()
+	r.pollingInterval = providerData.ClusterWaitPollingInterval
+}
+
+func (r *NetworkVerificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &NetworkVerificationState{}
+	diags := req.Plan.Get(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	subnetIds := common.OptionalList(plan.SubnetIDs)
+
+	waitTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultNetworkVerificationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aws := cmv1.NewAWS().STS(cmv1.NewSTS().RoleARN(plan.RoleARN.ValueString()))
+	tags, err := common.OptionalMap(ctx, plan.Tags)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid tags", err.Error())
+		return
+	}
+	if tags != nil {
+		aws.Tags(tags)
+	}
+	builder := cmv1.NewNetworkVerification().
+		CloudProviderData(cmv1.NewCloudProviderData().
+			AWS(aws).
+			Region(cmv1.NewCloudRegion().ID(plan.CloudRegion.ValueString())).
+			Subnets(subnetIds...))
+	if common.HasValue(plan.Platform) {
+		builder.Platform(cmv1.Platform(plan.Platform.ValueString()))
+	}
+	object, err := builder.Build()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't build network verification",
+			fmt.Sprintf("Can't build network verification of subnets %v: %v", subnetIds, err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Starting network verification", map[string]any{
+		"subnets": subnetIds,
+	})
+	_, err = r.collection.Add().Body(object).SendContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't start network verification",
+			fmt.Sprintf("Can't start network verification of subnets %v: %v", subnetIds, err),
+		)
+		return
+	}
+
+	results, err := common.WaitForNetworkVerification(ctx, r.collection, subnetIds, waitTimeout,
+		r.pollingInterval)
+	if err != nil {
+		resp.Diagnostics.AddError("Network verification didn't complete", err.Error())
+		return
+	}
+
+	// Report each failed check as a separate error. The state isn't saved in that case, so that
+	// the next apply runs the verification again:
+	for _, result := range results {
+		if result.State() != common.NetworkVerificationStateFailed {
+			continue
+		}
+		details := result.Details()
+		if len(details) == 0 {
+			details = []string{"no details were given"}
+		}
+		for _, detail := range details {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("subnet_ids"),
+				fmt.Sprintf("Network verification failed for subnet '%s'", result.ID()),
+				detail,
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(strings.Join(subnetIds, ","))
+	resultValues := make([]SubnetResult, len(results))
+	for i, result := range results {
+		details, diags := types.ListValueFrom(ctx, types.StringType, result.Details())
+		resp.Diagnostics.Append(diags...)
+		resultValues[i] = SubnetResult{
+			SubnetID: types.StringValue(subnetIds[i]),
+			State:    types.StringValue(result.State()),
+			Details:  details,
+		}
+	}
+	plan.Results, diags = types.ListValueFrom(ctx, subnetResultType, resultValues)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *NetworkVerificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The result of a verification doesn't change once it completes, and the server only keeps the
+	// last verification of each subnet, so there is nothing to refresh.
+	state := &NetworkVerificationState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *NetworkVerificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All the attributes that can be set require replacement, except the timeouts, so there is
+	// nothing to update.
+	plan := &NetworkVerificationState{}
+	diags := req.Plan.Get(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *NetworkVerificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Verifications can't be deleted, removing the resource from the state is enough.
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,20 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package networkverification
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type NetworkVerificationState struct {
+	ID          types.String   `tfsdk:"id"`
+	SubnetIDs   types.List     `tfsdk:"subnet_ids"`
+	CloudRegion types.String   `tfsdk:"cloud_region"`
+	RoleARN     types.String   `tfsdk:"role_arn"`
+	Platform    types.String   `tfsdk:"platform"`
+	Tags        types.Map      `tfsdk:"tags"`
+	Results     types.List     `tfsdk:"results"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,101 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ocmconfig reads the configuration file written by `ocm login` and `rosa login`.
+package ocmconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// EnvVar is the environment variable that the OCM CLI uses to point to its configuration file.
+const EnvVar = "OCM_CONFIG"
+
+// Config contains the settings of the OCM configuration file that are relevant to the provider.
+type Config struct {
+	URL          string `json:"url,omitempty"`
+	TokenURL     string `json:"token_url,omitempty"`
+	AccessToken  string `json:"access_token,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	ClientID     string `json:"client_id,omitempty"`
+	ClientSecret string `json:"client_secret,omitempty"`
+	User         string `json:"user,omitempty"`
+	Password     string `json:"password,omitempty"`
+	Insecure     bool   `json:"insecure,omitempty"`
+}
+
+// HasCredentials checks if the configuration contains credentials.
+func (c *Config) HasCredentials() bool {
+	return c.AccessToken != "" || c.RefreshToken != "" || c.ClientSecret != "" || c.User != ""
+}
+
+// Tokens returns the tokens of the configuration, if any.
+func (c *Config) Tokens() []string {
+	tokens := []string{}
+	if c.AccessToken != "" {
+		tokens = append(tokens, c.AccessToken)
+	}
+	if c.RefreshToken != "" {
+		tokens = append(tokens, c.RefreshToken)
+	}
+	return tokens
+}
+
+// Location returns the path of the OCM configuration file for the given profile. Without a
+// profile it is the file used by the OCM CLI: the one given by the OCM_CONFIG environment
+// variable, the legacy '~/.ocm.json' file if it exists, or else '~/.config/ocm/ocm.json'. The
+// file of a named profile is 'ocm.<profile>.json', in the same directory.
+func Location(profile string) (string, error) {
+	location, err := defaultLocation()
+	if err != nil {
+		return "", err
+	}
+	if profile == "" {
+		return location, nil
+	}
+	return filepath.Join(filepath.Dir(location), fmt.Sprintf("ocm.%s.json", profile)), nil
+}
+
+func defaultLocation() (string, error) {
+	if location := os.Getenv(EnvVar); location != "" {
+		return location, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("can't find the home directory: %v", err)
+	}
+	legacy := filepath.Join(home, ".ocm.json")
+	if _, err := os.Stat(legacy); err == nil {
+		return legacy, nil
+	}
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("can't find the configuration directory: %v", err)
+	}
+	return filepath.Join(configDir, "ocm", "ocm.json"), nil
+}
+
+// Load reads the OCM configuration file of the given profile and returns it together with its
+// path.
+func Load(profile string) (*Config, string, error) {
+	location, err := Location(profile)
+	if err != nil {
+		return nil, "", err
+	}
+	data, err := os.ReadFile(location)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, location, fmt.Errorf("OCM configuration file '%s' doesn't exist, "+
+				"log in with 'ocm login' or 'rosa login' first", location)
+		}
+		return nil, location, fmt.Errorf("can't read OCM configuration file '%s': %v", location, err)
+	}
+	config := &Config{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, location, fmt.Errorf("can't parse OCM configuration file '%s': %v", location, err)
+	}
+	return config, location, nil
+}
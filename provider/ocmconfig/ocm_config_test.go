@@ -0,0 +1,63 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package ocmconfig
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+)
+
+var _ = Describe("OCM configuration", func() {
+	var dir string
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		GinkgoT().Setenv(EnvVar, filepath.Join(dir, "ocm.json"))
+	})
+
+	It("loads the file given by OCM_CONFIG", func() {
+		Expect(os.WriteFile(filepath.Join(dir, "ocm.json"), []byte(`{
+			"url": "https://api.stage.openshift.com",
+			"access_token": "my-access-token",
+			"refresh_token": "my-refresh-token",
+			"client_id": "cloud-services",
+			"pager": "less"
+		}`), 0600)).To(Succeed())
+
+		config, location, err := Load("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(location).To(Equal(filepath.Join(dir, "ocm.json")))
+		Expect(config.URL).To(Equal("https://api.stage.openshift.com"))
+		Expect(config.ClientID).To(Equal("cloud-services"))
+		Expect(config.HasCredentials()).To(BeTrue())
+		Expect(config.Tokens()).To(Equal([]string{"my-access-token", "my-refresh-token"}))
+	})
+
+	It("loads the file of a named profile", func() {
+		Expect(os.WriteFile(filepath.Join(dir, "ocm.stage.json"), []byte(`{
+			"client_id": "my-client",
+			"client_secret": "my-secret"
+		}`), 0600)).To(Succeed())
+
+		config, location, err := Load("stage")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(location).To(Equal(filepath.Join(dir, "ocm.stage.json")))
+		Expect(config.HasCredentials()).To(BeTrue())
+		Expect(config.Tokens()).To(BeEmpty())
+	})
+
+	It("explains how to create a missing file", func() {
+		_, _, err := Load("prod")
+		Expect(err).To(MatchError(ContainSubstring("doesn't exist, log in with 'ocm login' or 'rosa login' first")))
+	})
+
+	It("fails with an invalid file", func() {
+		Expect(os.WriteFile(filepath.Join(dir, "ocm.json"), []byte(`{`), 0600)).To(Succeed())
+		_, _, err := Load("")
+		Expect(err).To(MatchError(ContainSubstring("can't parse OCM configuration file")))
+	})
+})
@@ -0,0 +1,16 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package ocmconfig
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestOCMConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "OCM Config Suite")
+}
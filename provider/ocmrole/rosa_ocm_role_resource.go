@@ -33,7 +33,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	sdk "github.com/openshift-online/ocm-sdk-go"
 	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
 
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
@@ -115,17 +114,18 @@ func (r *RosaOCMRoleLinkResource) Configure(
 		return
 	}
 
-	connection, ok := req.ProviderData.(*sdk.Connection)
+	providerData, ok := req.ProviderData.(*common.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
 			fmt.Sprintf(
-				"Expected *sdk.Connection, got: %T.",
+				"Expected *common.ProviderData, got: %T.",
 				req.ProviderData,
 			),
 		)
 		return
 	}
+	connection := providerData.Connection
 
 	r.currentAccountClient = connection.AccountsMgmt().V1().CurrentAccount()
 	r.organizationsClient = connection.AccountsMgmt().V1().Organizations()
@@ -553,8 +553,18 @@ func (r *RosaOCMRoleLinkResource) resolveOrgID(
 func (r *RosaOCMRoleLinkResource) findOCMRoleLabelWithStatus(
 	ctx context.Context, orgID string,
 ) (string, bool, int, error) {
-	listResp, err := r.organizationsClient.Organization(orgID).
-		Labels().List().SendContext(ctx)
+	return findLabelWithStatus(
+		ctx, r.organizationsClient.Organization(orgID).Labels(), ocmRoleLabelKey,
+	)
+}
+
+// findLabelWithStatus returns the value of the label with the given key,
+// whether it exists, the HTTP status code from the list call (0 if no
+// error), and any error.
+func findLabelWithStatus(
+	ctx context.Context, labels *amsv1.GenericLabelsClient, key string,
+) (string, bool, int, error) {
+	listResp, err := labels.List().SendContext(ctx)
 	if err != nil {
 		status := 0
 		if listResp != nil {
@@ -566,7 +576,7 @@ func (r *RosaOCMRoleLinkResource) findOCMRoleLabelWithStatus(
 	var labelValue string
 	hasLabel := false
 	listResp.Items().Each(func(label *amsv1.Label) bool {
-		if label.Key() == ocmRoleLabelKey {
+		if label.Key() == key {
 			labelValue = label.Value()
 			hasLabel = true
 			return false
@@ -0,0 +1,550 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocmrole
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+const userRoleLabelKey = "sts_user_role"
+
+type RosaUserRoleLinkResource struct {
+	currentAccountClient *amsv1.CurrentAccountClient
+	accountsClient       *amsv1.AccountsClient
+}
+
+var _ resource.ResourceWithConfigure = &RosaUserRoleLinkResource{}
+var _ resource.ResourceWithImportState = &RosaUserRoleLinkResource{}
+
+func NewUserRoleLink() resource.Resource {
+	return &RosaUserRoleLinkResource{}
+}
+
+func (r *RosaUserRoleLinkResource) Metadata(
+	ctx context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_rosa_user_role_link"
+}
+
+func (r *RosaUserRoleLinkResource) Schema(
+	ctx context.Context, req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Links an externally created AWS IAM user role " +
+			"to the current OCM account. The AWS IAM role and its " +
+			"trust relationship should be created using the AWS " +
+			"provider. This resource manages only the OCM-side link.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier (the role ARN).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_arn": schema.StringAttribute{
+				Description: "The ARN of the AWS IAM user role to link.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						roleARNRegex,
+						"must be a valid AWS IAM role ARN "+
+							"(e.g. arn:aws:iam::123456789012:role/name)",
+					),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[^,]+$`),
+						"must not contain commas",
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				Description: "Identifier of the OCM account the role is linked to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RosaUserRoleLinkResource) Configure(
+	ctx context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*common.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected *common.ProviderData, got: %T.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+	connection := providerData.Connection
+
+	r.currentAccountClient = connection.AccountsMgmt().V1().CurrentAccount()
+	r.accountsClient = connection.AccountsMgmt().V1().Accounts()
+}
+
+func (r *RosaUserRoleLinkResource) Create(
+	ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	plan := &RosaUserRoleLinkState{}
+	diags := req.Plan.Get(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID, err := r.resolveAccountID(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to resolve OCM account",
+			fmt.Sprintf(
+				"Could not determine the current account: %v",
+				err,
+			),
+		)
+		return
+	}
+
+	roleARN := plan.RoleARN.ValueString()
+
+	parsedARN, err := arn.Parse(roleARN)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid role ARN",
+			fmt.Sprintf("Could not parse role ARN: %v", err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf(
+		"Attempting to acquire lock for account %s", accountID,
+	))
+	labelMutex.Lock(accountID)
+	defer labelMutex.Unlock(accountID)
+	tflog.Debug(ctx, fmt.Sprintf(
+		"Acquired lock for account %s", accountID,
+	))
+
+	existing, hasLabel, _, err := r.findUserRoleLabelWithStatus(ctx, accountID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to check existing user role link",
+			fmt.Sprintf("Could not read account labels: %v", err),
+		)
+		return
+	}
+
+	if hasLabel {
+		if containsARN(existing, roleARN) {
+			resp.Diagnostics.AddError(
+				"User role already linked",
+				fmt.Sprintf(
+					"The role ARN %q is already linked to "+
+						"account %q. Use terraform import "+
+						"instead.",
+					roleARN, accountID,
+				),
+			)
+			return
+		}
+
+		if conflict := findSameAccountARN(
+			existing, parsedARN.AccountID, roleARN,
+		); conflict != "" {
+			tflog.Info(ctx, fmt.Sprintf(
+				"AWS account %s conflict: existing %s vs new %s",
+				parsedARN.AccountID, conflict, roleARN,
+			))
+			resp.Diagnostics.AddError(
+				"AWS account already has a linked role",
+				fmt.Sprintf(
+					"Account %q already has role %q from "+
+						"AWS account %s. Only one user role per AWS "+
+						"account can be linked to an OCM account.",
+					accountID, conflict, parsedARN.AccountID,
+				),
+			)
+			return
+		}
+
+		newValue := appendARN(existing, roleARN)
+		label, buildErr := amsv1.NewLabel().
+			Key(userRoleLabelKey).Value(newValue).Build()
+		if buildErr != nil {
+			resp.Diagnostics.AddError(
+				"Failed to build label",
+				fmt.Sprintf("%v", buildErr),
+			)
+			return
+		}
+		_, err = r.accountsClient.Account(accountID).
+			Labels().Label(userRoleLabelKey).
+			Update().Body(label).SendContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to update user role link",
+				fmt.Sprintf(
+					"Could not add role ARN to existing label: %v",
+					err,
+				),
+			)
+			return
+		}
+	} else {
+		label, buildErr := amsv1.NewLabel().
+			Key(userRoleLabelKey).Value(roleARN).Build()
+		if buildErr != nil {
+			resp.Diagnostics.AddError(
+				"Failed to build label",
+				fmt.Sprintf("%v", buildErr),
+			)
+			return
+		}
+		_, err = r.accountsClient.Account(accountID).
+			Labels().Add().Body(label).SendContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to link user role",
+				fmt.Sprintf(
+					"Could not create account label: %v", err,
+				),
+			)
+			return
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf(
+		"Linked role %s to account %s", roleARN, accountID,
+	))
+
+	plan.ID = types.StringValue(roleARN)
+	plan.AccountID = types.StringValue(accountID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *RosaUserRoleLinkResource) Read(
+	ctx context.Context, req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	state := &RosaUserRoleLinkState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleARN := state.RoleARN.ValueString()
+
+	accountID, err := r.resolveAccountID(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to resolve OCM account",
+			fmt.Sprintf(
+				"Could not determine account: %v", err,
+			),
+		)
+		return
+	}
+
+	labelValue, hasLabel, listStatus, err := r.findUserRoleLabelWithStatus(ctx, accountID)
+	if err != nil {
+		if listStatus == http.StatusNotFound {
+			tflog.Warn(ctx, fmt.Sprintf(
+				"Account %s not found (HTTP 404), removing from state",
+				accountID,
+			))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to read user role link",
+			fmt.Sprintf("Could not read account labels: %v", err),
+		)
+		return
+	}
+
+	if !hasLabel || !containsARN(labelValue, roleARN) {
+		tflog.Warn(ctx, fmt.Sprintf(
+			"User role link for %s not found in account %s, removing from state",
+			roleARN, accountID,
+		))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(roleARN)
+	state.AccountID = types.StringValue(accountID)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *RosaUserRoleLinkResource) Update(
+	ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	resp.Diagnostics.AddError(
+		"Update not supported",
+		"The user role link resource does not support in-place "+
+			"updates. Delete and recreate the resource instead.",
+	)
+}
+
+func (r *RosaUserRoleLinkResource) Delete(
+	ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	state := &RosaUserRoleLinkState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleARN := state.RoleARN.ValueString()
+
+	accountID, err := r.resolveAccountID(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to resolve OCM account",
+			fmt.Sprintf(
+				"Could not determine account: %v", err,
+			),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf(
+		"Attempting to acquire lock for account %s", accountID,
+	))
+	labelMutex.Lock(accountID)
+	defer labelMutex.Unlock(accountID)
+	tflog.Debug(ctx, fmt.Sprintf(
+		"Acquired lock for account %s", accountID,
+	))
+
+	labelValue, hasLabel, listStatus, err := r.findUserRoleLabelWithStatus(ctx, accountID)
+	if err != nil {
+		if listStatus == http.StatusNotFound {
+			tflog.Info(ctx, fmt.Sprintf(
+				"Account %s not found during delete, treating as already unlinked",
+				accountID,
+			))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to read user role link for deletion",
+			fmt.Sprintf("Could not read account labels: %v", err),
+		)
+		return
+	}
+
+	if !hasLabel || !containsARN(labelValue, roleARN) {
+		tflog.Info(ctx, fmt.Sprintf(
+			"Role %s not found in account %s, treating as already unlinked",
+			roleARN, accountID,
+		))
+		return
+	}
+
+	newValue := removeARN(labelValue, roleARN)
+	if newValue == "" {
+		deleteResp, deleteErr := r.accountsClient.Account(accountID).
+			Labels().Label(userRoleLabelKey).
+			Delete().SendContext(ctx)
+		if deleteErr != nil {
+			if deleteResp != nil &&
+				deleteResp.Status() == http.StatusNotFound {
+				tflog.Info(ctx, fmt.Sprintf(
+					"Label already deleted for account %s", accountID,
+				))
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Failed to unlink user role",
+				fmt.Sprintf(
+					"Could not delete account label: %v",
+					deleteErr,
+				),
+			)
+			return
+		}
+	} else {
+		label, buildErr := amsv1.NewLabel().
+			Key(userRoleLabelKey).Value(newValue).Build()
+		if buildErr != nil {
+			resp.Diagnostics.AddError(
+				"Failed to build label",
+				fmt.Sprintf("%v", buildErr),
+			)
+			return
+		}
+		_, err = r.accountsClient.Account(accountID).
+			Labels().Label(userRoleLabelKey).
+			Update().Body(label).SendContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to unlink user role",
+				fmt.Sprintf(
+					"Could not update account label: %v", err,
+				),
+			)
+			return
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf(
+		"Unlinked role %s from account %s", roleARN, accountID,
+	))
+}
+
+func (r *RosaUserRoleLinkResource) ImportState(
+	ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	roleARN := req.ID
+
+	if !roleARNRegex.MatchString(roleARN) {
+		resp.Diagnostics.AddError(
+			"Invalid role ARN format",
+			fmt.Sprintf(
+				"Import ID must be a valid AWS IAM role ARN "+
+					"(e.g. arn:aws:iam::123456789012:role/name). "+
+					"Got: %s", roleARN,
+			),
+		)
+		return
+	}
+	if strings.Contains(roleARN, ",") {
+		resp.Diagnostics.AddError(
+			"Invalid role ARN format",
+			"Import ID must not contain commas.",
+		)
+		return
+	}
+
+	accountID, err := r.resolveAccountID(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to resolve account during import",
+			fmt.Sprintf(
+				"Could not determine the current account: %v",
+				err,
+			),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf(
+		"Importing user role link: account=%s arn=%s", accountID, roleARN,
+	))
+
+	labelValue, hasLabel, _, err := r.findUserRoleLabelWithStatus(
+		ctx, accountID,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to verify role link during import",
+			fmt.Sprintf(
+				"Could not read account labels for %s: %v",
+				accountID, err,
+			),
+		)
+		return
+	}
+
+	if !hasLabel || !containsARN(labelValue, roleARN) {
+		resp.Diagnostics.AddError(
+			"Role not linked",
+			fmt.Sprintf(
+				"Role %s is not linked to account %s",
+				roleARN, accountID,
+			),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("role_arn"), roleARN)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), roleARN)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("account_id"), accountID)...)
+}
+
+func (r *RosaUserRoleLinkResource) resolveAccountID(
+	ctx context.Context,
+) (string, error) {
+	accountResp, err := r.currentAccountClient.Get().SendContext(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get current account: %w", err)
+	}
+	body, ok := accountResp.GetBody()
+	if !ok {
+		return "", fmt.Errorf("empty response from current account API")
+	}
+	accountID := body.ID()
+	if accountID == "" {
+		return "", fmt.Errorf("current account has no identifier")
+	}
+	return accountID, nil
+}
+
+// findUserRoleLabelWithStatus returns the label value, whether it exists,
+// the HTTP status code from the list call (0 if no error), and any error.
+func (r *RosaUserRoleLinkResource) findUserRoleLabelWithStatus(
+	ctx context.Context, accountID string,
+) (string, bool, int, error) {
+	return findLabelWithStatus(
+		ctx, r.accountsClient.Account(accountID).Labels(), userRoleLabelKey,
+	)
+}
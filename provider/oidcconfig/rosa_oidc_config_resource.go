@@ -21,15 +21,15 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 )
 
 type RosaOidcConfigResource struct {
@@ -93,14 +93,15 @@ func (o *RosaOidcConfigResource) Configure(ctx context.Context, req resource.Con
 		return
 	}
 
-	connection, ok := req.ProviderData.(*sdk.Connection)
+	providerData, ok := req.ProviderData.(*common.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.Connaction, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
+	connection := providerData.Connection
 
 	o.oidcConfigClient = connection.ClustersMgmt().V1().OidcConfigs()
 	o.clustersClient = connection.ClustersMgmt().V1().Clusters()
@@ -389,11 +390,7 @@ func (o *RosaOidcConfigResource) populateState(ctx context.Context, object *cmv1
 		state.SecretARN = types.StringValue(secretArn)
 	}
 
-	oidcEndpointURL := issuerUrl
-	if after, ok0 := strings.CutPrefix(oidcEndpointURL, "https://"); ok0 {
-		oidcEndpointURL = after
-	}
-	state.OIDCEndpointURL = types.StringValue(oidcEndpointURL)
+	state.OIDCEndpointURL = types.StringValue(common.OIDCEndpointURL(issuerUrl))
 
 	input, err := cmv1.NewOidcThumbprintInput().OidcConfigId(object.ID()).Build()
 	if err != nil {
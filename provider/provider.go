@@ -21,46 +21,70 @@ import (
 	"crypto/x509"
 	"fmt"
 	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	tfprovider "github.com/hashicorp/terraform-plugin-framework/provider"
 	tfpschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	sdk "github.com/openshift-online/ocm-sdk-go"
 
 	"github.com/terraform-redhat/terraform-provider-rhcs/build"
 	"github.com/terraform-redhat/terraform-provider-rhcs/logging"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/addon"
 	classicAutoscaler "github.com/terraform-redhat/terraform-provider-rhcs/provider/autoscaler/classic"
 	hcpAutoscaler "github.com/terraform-redhat/terraform-provider-rhcs/provider/autoscaler/hcp"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/breakglasscredential"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/cloudprovider"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/cluster"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/classic"
+	rosa "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/hcp"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/clusters"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterupgrades"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterwaiter"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 	defaultingress "github.com/terraform-redhat/terraform-provider-rhcs/provider/defaultingress/classic"
 	hcpingress "github.com/terraform-redhat/terraform-provider-rhcs/provider/defaultingress/hcp"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/dnsdomain"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/externalauthprovider"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/functions"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/group"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/groupmembership"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/identityprovider"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/imagemirror"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/info"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/kubeletconfig"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/limitedsupportreasons"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/logforwarder"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/machine_types"
 	machinepool "github.com/terraform-redhat/terraform-provider-rhcs/provider/machinepool/classic"
 	nodepool "github.com/terraform-redhat/terraform-provider-rhcs/provider/machinepool/hcp"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/networkverification"
 	classicStsPolicies "github.com/terraform-redhat/terraform-provider-rhcs/provider/ocm_policies/classic"
 	hcpStsPolicies "github.com/terraform-redhat/terraform-provider-rhcs/provider/ocm_policies/hcp"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/ocmconfig"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/ocmrole"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/oidcconfig"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/oidcconfiginput"
 	classicOperatorRoles "github.com/terraform-redhat/terraform-provider-rhcs/provider/rosa_operator_roles/classic"
 	hcpOperatorRoles "github.com/terraform-redhat/terraform-provider-rhcs/provider/rosa_operator_roles/hcp"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/servicelogs"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/trusted_ip_addresses"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/tuningconfigs"
+	hcpUpgradePolicy "github.com/terraform-redhat/terraform-provider-rhcs/provider/upgradepolicy/hcp"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/versiongateagreement"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/versions"
 )
 
@@ -68,9 +92,12 @@ import (
 type Provider struct{}
 
 var _ tfprovider.Provider = &Provider{}
+var _ tfprovider.ProviderWithEphemeralResources = &Provider{}
+var _ tfprovider.ProviderWithFunctions = &Provider{}
 
 // Config contains the configuration of the provider.
 type Config struct {
+	Environment  types.String `tfsdk:"environment"`
 	URL          types.String `tfsdk:"url"`
 	TokenURL     types.String `tfsdk:"token_url"`
 	Token        types.String `tfsdk:"token"`
@@ -79,6 +106,27 @@ type Config struct {
 	ClientSecret types.String `tfsdk:"client_secret"`
 	TrustedCAs   types.String `tfsdk:"trusted_cas"`
 	Insecure     types.Bool   `tfsdk:"insecure"`
+
+	ClusterWaitPollingInterval types.String `tfsdk:"cluster_wait_polling_interval"`
+	MaxRetries                 types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait               types.String `tfsdk:"retry_max_wait"`
+	ClusterCacheTTL            types.String `tfsdk:"cluster_cache_ttl"`
+
+	UseOCMConfig     types.Bool   `tfsdk:"use_ocm_config"`
+	OCMConfigProfile types.String `tfsdk:"ocm_config_profile"`
+
+	DefaultTags       *DefaultTagsConfig       `tfsdk:"default_tags"`
+	DefaultProperties *DefaultPropertiesConfig `tfsdk:"default_properties"`
+}
+
+// DefaultTagsConfig contains the tags that are added to the clusters and machine pools.
+type DefaultTagsConfig struct {
+	Tags types.Map `tfsdk:"tags"`
+}
+
+// DefaultPropertiesConfig contains the properties that are added to the clusters.
+type DefaultPropertiesConfig struct {
+	Properties types.Map `tfsdk:"properties"`
 }
 
 // New creates the provider.
@@ -95,6 +143,17 @@ func (p *Provider) Metadata(ctx context.Context, req tfprovider.MetadataRequest,
 func (p *Provider) Schema(ctx context.Context, req tfprovider.SchemaRequest, resp *tfprovider.SchemaResponse) {
 	resp.Schema = tfpschema.Schema{
 		Attributes: map[string]tfpschema.Attribute{
+			"environment": tfpschema.StringAttribute{
+				Description: fmt.Sprintf("Name of the OCM environment to connect to, which sets matching values for "+
+					"`url`, `token_url` and `client_id`. Valid values are %s. It can't be combined with a `url` "+
+					"of a different environment, but `token_url` and `client_id` can still be overridden. "+
+					"The default is to use the `url` and `token_url` attributes.",
+					strings.Join(common.EnvironmentNames(), ", ")),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(common.EnvironmentNames()...),
+				},
+			},
 			"url": tfpschema.StringAttribute{
 				Description: fmt.Sprintf("URL sets the base URL of the API gateway. The default is `%s`", sdk.DefaultURL),
 				Optional:    true,
@@ -137,6 +196,76 @@ func (p *Provider) Schema(ctx context.Context, req tfprovider.SchemaRequest, res
 					"for production environments.",
 				Optional: true,
 			},
+			"use_ocm_config": tfpschema.BoolAttribute{
+				Description: "When set to 'true' loads the URL and the credentials from the configuration file written by " +
+					"`ocm login` or `rosa login`, which is the file given by the `OCM_CONFIG` environment variable or else " +
+					"'~/.config/ocm/ocm.json'. The provider attributes and the `RHCS_*` environment variables take precedence " +
+					"over the settings of the file. The default value is 'false'.",
+				Optional: true,
+			},
+			"ocm_config_profile": tfpschema.StringAttribute{
+				Description: "Name of the profile of the OCM configuration to load. The configuration of a profile is " +
+					"stored in the 'ocm.<profile>.json' file next to the default OCM configuration file. " +
+					"Setting it implies `use_ocm_config`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`),
+						"must only contain letters, digits, '_', '.' and '-'"),
+				},
+			},
+			"cluster_wait_polling_interval": tfpschema.StringAttribute{
+				Description: fmt.Sprintf("Interval between two polls of a cluster while waiting for it, for example `30s` or `1m`. "+
+					"The default value is '%s'.", common.DefaultClusterWaitPollingInterval),
+				Optional: true,
+			},
+			"max_retries": tfpschema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of times that a request to the API gateway is retried when it fails "+
+					"with a transient error, like '429 Too Many Requests' or '503 Service Unavailable'. "+
+					"Set it to 0 to disable retries. It can't exceed %d. The default value is %d.",
+					common.MaxRetriesLimit, common.DefaultMaxRetries),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+					int64validator.AtMost(common.MaxRetriesLimit),
+				},
+			},
+			"retry_max_wait": tfpschema.StringAttribute{
+				Description: fmt.Sprintf("Maximum time to wait before retrying a request to the API gateway, for example `10s` or `1m`. "+
+					"It also limits the wait requested by the 'Retry-After' header. The default value is '%s'.", common.DefaultRetryMaxWait),
+				Optional: true,
+			},
+			"cluster_cache_ttl": tfpschema.StringAttribute{
+				Description: fmt.Sprintf("Time that a cluster fetched from the API gateway is reused by the other resources of "+
+					"the same run, for example `10s` or `1m`. Any change to the cluster discards it. "+
+					"Set it to `0s` to disable the cache. The default value is '%s'.", common.DefaultClusterCacheTTL),
+				Optional: true,
+			},
+		},
+		Blocks: map[string]tfpschema.Block{
+			"default_tags": tfpschema.SingleNestedBlock{
+				Description: "Tags that are added to the AWS tags of every cluster and machine pool created by the provider. " +
+					"The tags of the resources take precedence over the default tags. AWS tags can't be changed once a " +
+					"resource exists, so the default tags only apply to new resources.",
+				Attributes: map[string]tfpschema.Attribute{
+					"tags": tfpschema.MapAttribute{
+						Description: "Default AWS tags.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+			"default_properties": tfpschema.SingleNestedBlock{
+				Description: "Properties that are added to the properties of every cluster managed by the provider. " +
+					"The properties of the clusters take precedence over the default properties.",
+				Attributes: map[string]tfpschema.Attribute{
+					"properties": tfpschema.MapAttribute{
+						Description: "Default cluster properties.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators:  []validator.Map{rosa.PropertiesValidator},
+					},
+				},
+			},
 		},
 	}
 }
@@ -173,23 +302,113 @@ func (p *Provider) Configure(ctx context.Context, req tfprovider.ConfigureReques
 	builder.Logger(logger)
 	builder.Agent(fmt.Sprintf("OCM-TF/%s-%s", build.Version, build.Commit))
 
+	// Load the OCM configuration file, if requested. Its settings are only used for what isn't
+	// given by the provider attributes or the RHCS_* environment variables:
+	var ocmConfig *ocmconfig.Config
+	var ocmConfigSource string
+	useOCMConfig := config.UseOCMConfig.ValueBool()
+	if value, ok := os.LookupEnv("RHCS_USE_OCM_CONFIG"); ok && config.UseOCMConfig.IsNull() {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid OCM configuration flag",
+				fmt.Sprintf("Expected a boolean in 'RHCS_USE_OCM_CONFIG', got '%s'", value),
+			)
+			return
+		}
+		useOCMConfig = parsed
+	}
+	profile, profileExists := p.getAttrValueOrConfig(config.OCMConfigProfile, "OCM_CONFIG_PROFILE")
+	if useOCMConfig || profileExists {
+		loaded, location, err := ocmconfig.Load(profile)
+		if err != nil {
+			resp.Diagnostics.AddError("Can't load OCM configuration", err.Error())
+			return
+		}
+		ocmConfig = loaded
+		ocmConfigSource = fmt.Sprintf("OCM configuration file '%s'", location)
+	}
+
+	// Resolve the environment, which takes precedence over the OCM configuration file:
+	var environment *common.Environment
+	if name, ok := p.getAttrValueOrConfig(config.Environment, "ENVIRONMENT"); ok {
+		resolved, found := common.LookupEnvironment(name)
+		if !found {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("environment"),
+				"Invalid environment",
+				fmt.Sprintf("Expected one of %s, got '%s'", strings.Join(common.EnvironmentNames(), ", "), name),
+			)
+			return
+		}
+		environment = &resolved
+		tflog.Info(ctx, fmt.Sprintf("Using environment '%s' with URL '%s'", environment.Name, environment.URL))
+	}
+
 	// Copy the settings:
 	if url, ok := p.getAttrValueOrConfig(config.URL, "URL"); ok {
+		if environment != nil && strings.TrimSuffix(url, "/") != environment.URL {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("url"),
+				"Conflicting environment",
+				fmt.Sprintf("The URL '%s' doesn't belong to environment '%s', which uses '%s'. "+
+					"Remove one of 'url' or 'environment'", url, environment.Name, environment.URL),
+			)
+			return
+		}
 		builder.URL(url)
+	} else if environment != nil {
+		builder.URL(environment.URL)
+	} else if ocmConfig != nil && ocmConfig.URL != "" {
+		builder.URL(ocmConfig.URL)
+		tflog.Info(ctx, fmt.Sprintf("Using URL '%s' from %s", ocmConfig.URL, ocmConfigSource))
 	}
 	if tokenURL, ok := p.getAttrValueOrConfig(config.TokenURL, "TOKEN_URL"); ok {
 		builder.TokenURL(tokenURL)
+	} else if environment != nil {
+		builder.TokenURL(environment.TokenURL)
+	} else if ocmConfig != nil && ocmConfig.TokenURL != "" {
+		builder.TokenURL(ocmConfig.TokenURL)
 	}
+	hasCredentials := false
 	if token, ok := p.getAttrValueOrConfig(config.Token, "TOKEN"); ok {
 		builder.Tokens(token)
+		hasCredentials = true
 	}
 	if refreshToken, ok := p.getAttrValueOrConfig(config.RefreshToken, "REFRESH_TOKEN"); ok {
 		builder.Tokens(refreshToken)
+		hasCredentials = true
 	}
 	clientID, clientIdExists := p.getAttrValueOrConfig(config.ClientID, "CLIENT_ID")
-	clientSecret, _ := p.getAttrValueOrConfig(config.ClientSecret, "CLIENT_SECRET")
+	clientSecret, clientSecretExists := p.getAttrValueOrConfig(config.ClientSecret, "CLIENT_SECRET")
 	if clientIdExists {
 		builder.Client(clientID, clientSecret)
+		hasCredentials = hasCredentials || clientSecretExists
+	} else if environment != nil {
+		builder.Client(environment.ClientID, clientSecret)
+		hasCredentials = hasCredentials || clientSecretExists
+	}
+	switch {
+	case hasCredentials:
+		tflog.Info(ctx, "Using credentials from the provider configuration or the RHCS_* environment variables")
+	case ocmConfig != nil && ocmConfig.HasCredentials():
+		if tokens := ocmConfig.Tokens(); len(tokens) > 0 {
+			builder.Tokens(tokens...)
+		}
+		// The client of the environment is kept unless the file has its own client secret:
+		if ocmConfig.ClientID != "" && !clientIdExists && (environment == nil || ocmConfig.ClientSecret != "") {
+			builder.Client(ocmConfig.ClientID, ocmConfig.ClientSecret)
+		}
+		if ocmConfig.User != "" {
+			builder.User(ocmConfig.User, ocmConfig.Password)
+		}
+		tflog.Info(ctx, fmt.Sprintf("Using credentials from %s", ocmConfigSource))
+	case ocmConfig != nil:
+		resp.Diagnostics.AddError(
+			"Can't load OCM configuration",
+			fmt.Sprintf("The %s doesn't contain credentials, log in with 'ocm login' or 'rosa login' first", ocmConfigSource),
+		)
+		return
 	}
 	if trustedCAs, ok := p.getAttrValueOrConfig(config.TrustedCAs, "TRUSTED_CAS"); ok {
 		pool := x509.NewCertPool()
@@ -204,8 +423,91 @@ func (p *Provider) Configure(ctx context.Context, req tfprovider.ConfigureReques
 	}
 	if !config.Insecure.IsNull() {
 		builder.Insecure(config.Insecure.ValueBool())
+	} else if ocmConfig != nil && ocmConfig.Insecure {
+		builder.Insecure(true)
+	}
+
+	pollingInterval := common.DefaultClusterWaitPollingInterval
+	if value, ok := p.getAttrValueOrConfig(config.ClusterWaitPollingInterval, "CLUSTER_WAIT_POLLING_INTERVAL"); ok {
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("cluster_wait_polling_interval"),
+				"Invalid cluster wait polling interval",
+				fmt.Sprintf("Expected a positive duration such as '30s' or '2m', got '%s'", value),
+			)
+			return
+		}
+		pollingInterval = interval
+	}
+
+	maxRetries := common.DefaultMaxRetries
+	if !config.MaxRetries.IsNull() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
+	} else if value, ok := os.LookupEnv("RHCS_MAX_RETRIES"); ok {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			resp.Diagnostics.AddError(
+				"Invalid maximum number of retries",
+				fmt.Sprintf("Expected a non-negative integer in 'RHCS_MAX_RETRIES', got '%s'", value),
+			)
+			return
+		}
+		maxRetries = parsed
+	}
+	retryMaxWait := common.DefaultRetryMaxWait
+	if value, ok := p.getAttrValueOrConfig(config.RetryMaxWait, "RETRY_MAX_WAIT"); ok {
+		wait, err := time.ParseDuration(value)
+		if err != nil || wait < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"Invalid maximum retry wait",
+				fmt.Sprintf("Expected a non-negative duration such as '10s' or '1m', got '%s'", value),
+			)
+			return
+		}
+		retryMaxWait = wait
+	}
+	clusterCacheTTL := common.DefaultClusterCacheTTL
+	if value, ok := p.getAttrValueOrConfig(config.ClusterCacheTTL, "CLUSTER_CACHE_TTL"); ok {
+		ttl, err := time.ParseDuration(value)
+		if err != nil || ttl < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("cluster_cache_ttl"),
+				"Invalid cluster cache TTL",
+				fmt.Sprintf("Expected a non-negative duration such as '10s' or '1m', got '%s'", value),
+			)
+			return
+		}
+		clusterCacheTTL = ttl
+	}
+
+	defaultTags := map[string]string{}
+	if config.DefaultTags != nil {
+		tags, err := common.OptionalMap(ctx, config.DefaultTags.Tags)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("default_tags").AtName("tags"), "Invalid default tags", err.Error())
+			return
+		}
+		defaultTags = tags
+	}
+	defaultProperties := map[string]string{}
+	if config.DefaultProperties != nil {
+		properties, err := common.OptionalMap(ctx, config.DefaultProperties.Properties)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("default_properties").AtName("properties"),
+				"Invalid default properties", err.Error())
+			return
+		}
+		defaultProperties = properties
 	}
 
+	// The cache goes first so that cached clusters skip the retries. The retries of the SDK are
+	// replaced by ours, which also honor the 'Retry-After' header:
+	builder.TransportWrapper(common.NewClusterCache(clusterCacheTTL).TransportWrapper())
+	builder.RetryLimit(0)
+	builder.TransportWrapper(common.RetryTransportWrapper(maxRetries, retryMaxWait))
+
 	// Create the connection:
 	connection, err := builder.BuildContext(ctx)
 	if err != nil {
@@ -213,9 +515,16 @@ func (p *Provider) Configure(ctx context.Context, req tfprovider.ConfigureReques
 		return
 	}
 
-	// Save the connection:
-	resp.DataSourceData = connection
-	resp.ResourceData = connection
+	// Save the connection and the settings of this configuration of the provider:
+	providerData := &common.ProviderData{
+		Connection:                 connection,
+		ClusterWaitPollingInterval: pollingInterval,
+		DefaultTags:                defaultTags,
+		DefaultProperties:          defaultProperties,
+	}
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
+	resp.EphemeralResourceData = providerData
 }
 
 // Resources returns the resources supported by the provider.
@@ -241,7 +550,13 @@ func (p *Provider) Resources(ctx context.Context) []func() resource.Resource {
 		hcpAutoscaler.New,
 		breakglasscredential.New,
 		logforwarder.New,
+		externalauthprovider.New,
+		hcpUpgradePolicy.New,
+		versiongateagreement.New,
+		addon.New,
+		networkverification.New,
 		ocmrole.New,
+		ocmrole.NewUserRoleLink,
 	}
 }
 
@@ -253,6 +568,11 @@ func (p *Provider) DataSources(ctx context.Context) []func() datasource.DataSour
 		classicStsPolicies.New,
 		classicOperatorRoles.New,
 		versions.New,
+		clusters.New,
+		clusterupgrades.New,
+		addon.NewDataSource,
+		limitedsupportreasons.New,
+		servicelogs.New,
 		info.New,
 		classic.NewDataSource,
 		machinepool.NewDatasource,
@@ -265,3 +585,21 @@ func (p *Provider) DataSources(ctx context.Context) []func() datasource.DataSour
 		logforwarder.NewDataSource,
 	}
 }
+
+// EphemeralResources returns the ephemeral resources supported by the provider.
+func (p *Provider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		breakglasscredential.NewEphemeral,
+	}
+}
+
+// Functions returns the provider-defined functions supported by the provider.
+func (p *Provider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewOperatorRoleName,
+		functions.NewCompareVersions,
+		functions.NewParseRoleARN,
+		functions.NewIsValidDomainPrefix,
+		functions.NewOIDCEndpointURL,
+	}
+}
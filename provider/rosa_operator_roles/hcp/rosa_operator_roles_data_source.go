@@ -25,7 +25,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
@@ -104,7 +103,7 @@ func (s *RosaOperatorRolesDataSource) Configure(ctx context.Context, req datasou
 	}
 
 	// Cast the provider data to the specific implementation:
-	connection := req.ProviderData.(*sdk.Connection)
+	connection := req.ProviderData.(*common.ProviderData).Connection
 
 	// Get the collection of cloud providers:
 	s.awsInquiries = connection.ClustersMgmt().V1().AWSInquiries()
@@ -175,11 +174,7 @@ func (s *RosaOperatorRolesDataSource) Read(ctx context.Context, req datasource.R
 
 // TODO: should be in a separate repo
 func getRoleName(rolePrefix string, operatorRole *cmv1.STSOperator) string {
-	role := fmt.Sprintf("%s-%s-%s", rolePrefix, operatorRole.Namespace(), operatorRole.Name())
-	if len(role) > 64 {
-		role = role[0:64]
-	}
-	return role
+	return common.OperatorRoleName(rolePrefix, operatorRole.Namespace(), operatorRole.Name())
 }
 
 // TODO: should be in a separate repo
@@ -0,0 +1,238 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package servicelogs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common/attrvalidators"
+)
+
+var severities = []string{
+	string(slv1.SeverityDebug),
+	string(slv1.SeverityInfo),
+	string(slv1.SeverityLow),
+	string(slv1.SeverityModerate),
+	string(slv1.SeverityWarning),
+	string(slv1.SeverityImportant),
+	string(slv1.SeverityMajor),
+	string(slv1.SeverityError),
+	string(slv1.SeverityCritical),
+	string(slv1.SeverityFatal),
+}
+
+type ServiceLogsDataSource struct {
+	collection *slv1.ClustersClusterLogsClient
+}
+
+var _ datasource.DataSource = &ServiceLogsDataSource{}
+var _ datasource.DataSourceWithConfigure = &ServiceLogsDataSource{}
+
+func New() datasource.DataSource {
+	return &ServiceLogsDataSource{}
+}
+
+func (d *ServiceLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_service_logs"
+}
+
+func (d *ServiceLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Service log entries of a cluster, newest first. These are the messages sent to the owners " +
+			"of the cluster by Red Hat, for example about incidents or required actions. All the given filters " +
+			"must match.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Identifier of the cluster.",
+				Required:    true,
+			},
+			"severities": schema.ListAttribute{
+				Description: fmt.Sprintf("Severities of the entries, any of '%s'.", strings.Join(severities, "', '")),
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(severities...)),
+				},
+			},
+			"service_name": schema.StringAttribute{
+				Description: "Name of the service that sent the entries, for example 'SREManualAction'.",
+				Optional:    true,
+			},
+			"start_time": schema.StringAttribute{
+				Description: "Only entries sent at this time or later, in RFC3339 format, for example " +
+					"`timeadd(timestamp(), \"-24h\")`.",
+				Optional: true,
+				Validators: []validator.String{
+					attrvalidators.RFC3339TimestampValidator(),
+				},
+			},
+			"end_time": schema.StringAttribute{
+				Description: "Only entries sent before this time, in RFC3339 format.",
+				Optional:    true,
+				Validators: []validator.String{
+					attrvalidators.RFC3339TimestampValidator(),
+				},
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "Entries that match the filters, newest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Unique identifier of the entry.",
+							Computed:    true,
+						},
+						"timestamp": schema.StringAttribute{
+							Description: "Date and time of the entry, in RFC3339 format.",
+							Computed:    true,
+						},
+						"severity": schema.StringAttribute{
+							Description: "Severity of the entry, for example 'Error'.",
+							Computed:    true,
+						},
+						"service_name": schema.StringAttribute{
+							Description: "Name of the service that sent the entry.",
+							Computed:    true,
+						},
+						"log_type": schema.StringAttribute{
+							Description: "Type of the entry, for example 'cluster-state-updates'.",
+							Computed:    true,
+						},
+						"summary": schema.StringAttribute{
+							Description: "Summary of the entry.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Full description of the entry.",
+							Computed:    true,
+						},
+						"doc_references": schema.ListAttribute{
+							Description: "Links to documentation related to the entry.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *ServiceLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured:
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Cast the provider data to the specific implementation:
+	connection := req.ProviderData.(*common.ProviderData).Connection
+
+	// Get the collection of cluster logs:
+	d.collection = connection.ServiceLogs().V1().Clusters().ClusterLogs()
+}
+
+func (d *ServiceLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the state:
+	state := &ServiceLogsState{}
+	diags := req.Config.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clusterId := state.Cluster.ValueString()
+
+	// Fetch the entries:
+	var listItems []*slv1.LogEntry
+	listSize := 100
+	listPage := 1
+	listRequest := d.collection.List().
+		ClusterID(clusterId).
+		Order("timestamp desc").
+		Size(listSize)
+	if search := buildSearch(state); search != "" {
+		listRequest.Search(search)
+	}
+	for {
+		listResponse, err := listRequest.SendContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Can't list service logs",
+				fmt.Sprintf("Can't list service logs of cluster '%s': %v", clusterId, err),
+			)
+			return
+		}
+		if listItems == nil {
+			listItems = make([]*slv1.LogEntry, 0, listResponse.Total())
+		}
+		listItems = append(listItems, listResponse.Items().Slice()...)
+		if listResponse.Size() < listSize {
+			break
+		}
+		listPage++
+		listRequest.Page(listPage)
+	}
+
+	// Populate the state:
+	state.Items = make([]*LogEntryState, len(listItems))
+	for i, listItem := range listItems {
+		docReferences, diags := types.ListValueFrom(ctx, types.StringType, listItem.DocReferences())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Items[i] = &LogEntryState{
+			ID:            types.StringValue(listItem.ID()),
+			Timestamp:     types.StringValue(listItem.Timestamp().UTC().Format(time.RFC3339)),
+			Severity:      types.StringValue(string(listItem.Severity())),
+			ServiceName:   types.StringValue(listItem.ServiceName()),
+			LogType:       types.StringValue(string(listItem.LogType())),
+			Summary:       types.StringValue(listItem.Summary()),
+			Description:   types.StringValue(listItem.Description()),
+			DocReferences: docReferences,
+		}
+	}
+
+	// Save the state:
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// buildSearch translates the filters into a search expression of the service logs API.
+func buildSearch(state *ServiceLogsState) string {
+	terms := []string{}
+	if values := common.OptionalList(state.Severities); len(values) > 0 {
+		quoted := make([]string, len(values))
+		for i, value := range values {
+			quoted[i] = quote(value)
+		}
+		terms = append(terms, fmt.Sprintf("severity in (%s)", strings.Join(quoted, ", ")))
+	}
+	if common.HasValue(state.ServiceName) {
+		terms = append(terms, fmt.Sprintf("service_name = %s", quote(state.ServiceName.ValueString())))
+	}
+	if common.HasValue(state.StartTime) {
+		terms = append(terms, fmt.Sprintf("timestamp >= %s", quote(state.StartTime.ValueString())))
+	}
+	if common.HasValue(state.EndTime) {
+		terms = append(terms, fmt.Sprintf("timestamp < %s", quote(state.EndTime.ValueString())))
+	}
+	return strings.Join(terms, " and ")
+}
+
+// quote returns the given value as a string literal of the search language.
+func quote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
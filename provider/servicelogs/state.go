@@ -0,0 +1,26 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package servicelogs
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type ServiceLogsState struct {
+	Cluster     types.String     `tfsdk:"cluster"`
+	Severities  types.List       `tfsdk:"severities"`
+	ServiceName types.String     `tfsdk:"service_name"`
+	StartTime   types.String     `tfsdk:"start_time"`
+	EndTime     types.String     `tfsdk:"end_time"`
+	Items       []*LogEntryState `tfsdk:"items"`
+}
+
+type LogEntryState struct {
+	ID            types.String `tfsdk:"id"`
+	Timestamp     types.String `tfsdk:"timestamp"`
+	Severity      types.String `tfsdk:"severity"`
+	ServiceName   types.String `tfsdk:"service_name"`
+	LogType       types.String `tfsdk:"log_type"`
+	Summary       types.String `tfsdk:"summary"`
+	Description   types.String `tfsdk:"description"`
+	DocReferences types.List   `tfsdk:"doc_references"`
+}
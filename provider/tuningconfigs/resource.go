@@ -11,8 +11,9 @@ import (
 	"regexp"
 	"strings"
 
-	"sigs.k8s.io/yaml"
-
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -23,7 +24,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
@@ -41,11 +41,78 @@ func New() resource.Resource {
 var _ resource.Resource = &TuningConfigResource{}
 var _ resource.ResourceWithImportState = &TuningConfigResource{}
 var _ resource.ResourceWithConfigure = &TuningConfigResource{}
+var _ resource.ResourceWithConfigValidators = &TuningConfigResource{}
 
 func (r *TuningConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_tuning_config"
 }
 
+func (r *TuningConfigResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		&tuningConfigRecommendValidator{},
+	}
+}
+
+type tuningConfigRecommendValidator struct{}
+
+func (v *tuningConfigRecommendValidator) Description(_ context.Context) string {
+	return "Profile names must be unique and each 'recommend' rule must select one of the profiles in 'profile'"
+}
+
+func (v *tuningConfigRecommendValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v *tuningConfigRecommendValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var profiles, recommends types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("profile"), &profiles)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("recommend"), &recommends)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip validation if values are unknown (e.g., during plan)
+	if profiles.IsNull() || profiles.IsUnknown() || recommends.IsNull() || recommends.IsUnknown() {
+		return
+	}
+
+	profileList := []*TunedProfile{}
+	resp.Diagnostics.Append(profiles.ElementsAs(ctx, &profileList, false)...)
+	recommendList := []*TunedRecommend{}
+	resp.Diagnostics.Append(recommends.ElementsAs(ctx, &recommendList, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := map[string]bool{}
+	for i, profile := range profileList {
+		if profile.Name.IsUnknown() {
+			return
+		}
+		name := profile.Name.ValueString()
+		if names[name] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("profile").AtListIndex(i).AtName("name"),
+				"Duplicate profile name",
+				fmt.Sprintf("Profile name '%s' is used by more than one profile", name),
+			)
+		}
+		names[name] = true
+	}
+	for i, recommend := range recommendList {
+		if recommend.Profile.IsUnknown() {
+			continue
+		}
+		if !names[recommend.Profile.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("recommend").AtListIndex(i).AtName("profile"),
+				"Unknown profile",
+				fmt.Sprintf("Profile '%s' isn't defined in 'profile'", recommend.Profile.ValueString()),
+			)
+		}
+	}
+}
+
 func (r *TuningConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Edit a cluster tuning config",
@@ -71,9 +138,67 @@ func (r *TuningConfigResource) Schema(ctx context.Context, req resource.SchemaRe
 				Required:    true,
 			},
 			"spec": schema.StringAttribute{
-				Description: "Definition of the spec. It is required to supply this field wrapped in a jsonencode call. Example: jsonencode({<tuning_config_spec})",
-				Required:    true,
+				Description: "Definition of the spec. It is required to supply this field wrapped in a jsonencode call. Example: jsonencode({<tuning_config_spec})" +
+					". Changes that only affect the formatting or the order of the keys are ignored. " +
+					"Conflicts with 'profile' and 'recommend'.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("profile")),
+					stringvalidator.ConflictsWith(path.MatchRoot("recommend")),
+				},
+				PlanModifiers: []planmodifier.String{
+					specSemanticEquality(),
+				},
+			},
+			"profile": schema.ListNestedAttribute{
+				Description: "List of Tuned profiles. Conflicts with 'spec'.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the Tuned profile.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`), "profile name may not be empty/blank string"),
+							},
+						},
+						"data": schema.StringAttribute{
+							Description: "Specification of the Tuned profile, in the Tuned daemon configuration format.",
+							Required:    true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.AlsoRequires(path.MatchRoot("recommend")),
+				},
 			},
+			"recommend": schema.ListNestedAttribute{
+				Description: "List of rules that select the Tuned profile to apply. Conflicts with 'spec'.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"priority": schema.Int64Attribute{
+							Description: "Priority of the rule. Lower numbers mean higher priority.",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"profile": schema.StringAttribute{
+							Description: "Name of the Tuned profile to apply. It must be one of the profiles in 'profile'.",
+							Required:    true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.AlsoRequires(path.MatchRoot("profile")),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true}),
 		},
 	}
 	return
@@ -84,16 +209,18 @@ func (r *TuningConfigResource) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 
-	connection, ok := req.ProviderData.(*sdk.Connection)
+	providerData, ok := req.ProviderData.(*common.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.Connaction, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
+	connection := providerData.Connection
 
 	r.collection = connection.ClustersMgmt().V1().Clusters()
-	r.clusterWait = common.NewClusterWait(r.collection, connection)
+	r.clusterWait = common.NewClusterWait(r.collection, connection,
+		common.WithPollingInterval(providerData.ClusterWaitPollingInterval))
 }
 
 func (r *TuningConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -105,7 +232,12 @@ func (r *TuningConfigResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Wait till the cluster is ready:
-	_, err := r.clusterWait.WaitForClusterToBeReady(ctx, plan.Cluster.ValueString(), 60)
+	waitTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultClusterReadyTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, err := r.clusterWait.WaitForClusterToBeReady(ctx, plan.Cluster.ValueString(), common.DurationToMinutes(waitTimeout))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Cannot poll cluster state",
@@ -269,9 +401,29 @@ func (r *TuningConfigResource) populateTuningConfig(
 	}
 	tuningConfig := tuningConfigResp.Body()
 
-	return r.populateState(tuningConfig, state)
+	return r.refreshState(tuningConfig, state)
+}
+
+// refreshState updates the state with the tuning config returned by OCM, keeping the spec
+// stored in the state if it has the same content.
+func (r *TuningConfigResource) refreshState(tuningConfig *cmv1.TuningConfig, state *TuningConfig) error {
+	state.Id = types.StringValue(tuningConfig.ID())
+	state.Name = types.StringValue(tuningConfig.Name())
+	if hasStructuredSpec(state) {
+		return populateStructuredSpec(tuningConfig.Spec(), state)
+	}
+	byteResponseSpec, err := json.Marshal(tuningConfig.Spec())
+	if err != nil {
+		return err
+	}
+	// Keep the spec of the state unless the spec has been changed outside of Terraform:
+	if state.Spec.IsNull() || !specsAreEqual(state.Spec.ValueString(), string(byteResponseSpec)) {
+		state.Spec = types.StringValue(string(byteResponseSpec))
+	}
+	return nil
 }
 
+// populateState checks that the tuning config returned by OCM after an apply matches the plan.
 func (r *TuningConfigResource) populateState(tuningConfig *cmv1.TuningConfig, state *TuningConfig) error {
 	if state == nil {
 		state = &TuningConfig{}
@@ -283,20 +435,21 @@ func (r *TuningConfigResource) populateState(tuningConfig *cmv1.TuningConfig, st
 	if err != nil {
 		return err
 	}
-	// Importing the resource
-	if state.Spec == types.StringNull() && tuningConfig.Spec() != nil {
-		state.Spec = types.StringValue(string(byteResponseSpec[:]))
-	}
 	responseSpec, err := parseInputString(byteResponseSpec)
 	if err != nil {
 		return err
 	}
-	stateSpec, err := parseInputString([]byte(state.Spec.ValueString()))
+	var planSpec map[string]any
+	if hasStructuredSpec(state) {
+		planSpec, err = structuredSpec(state)
+	} else {
+		planSpec, err = parseInputString([]byte(state.Spec.ValueString()))
+	}
 	if err != nil {
 		return err
 	}
-	if !reflect.DeepEqual(responseSpec, stateSpec) {
-		return fmt.Errorf("Provider produced inconsistent result after apply, spec was '%s' but now is '%s'", responseSpec, stateSpec)
+	if !reflect.DeepEqual(responseSpec, planSpec) {
+		return fmt.Errorf("Provider produced inconsistent result after apply, spec was '%s' but now is '%s'", responseSpec, planSpec)
 	}
 
 	return nil
@@ -377,7 +530,13 @@ func (r *TuningConfigResource) updateTuningConfig(ctx context.Context, state, pl
 
 func getTuningConfigBuilder(plan *TuningConfig) (*cmv1.TuningConfigBuilder, error) {
 	tuningConfigBuilder := cmv1.NewTuningConfig()
-	if !common.IsStringAttributeUnknownOrEmpty(plan.Spec) {
+	if hasStructuredSpec(plan) {
+		spec, err := structuredSpec(plan)
+		if err != nil {
+			return nil, err
+		}
+		tuningConfigBuilder.Spec(spec)
+	} else if !common.IsStringAttributeUnknownOrEmpty(plan.Spec) {
 		parsedSpec, err := parseInputString([]byte(plan.Spec.ValueString()))
 		if err != nil {
 			return nil, err
@@ -386,12 +545,3 @@ func getTuningConfigBuilder(plan *TuningConfig) (*cmv1.TuningConfigBuilder, erro
 	}
 	return tuningConfigBuilder, nil
 }
-
-func parseInputString(input []byte) (map[string]any, error) {
-	var validSpec map[string]any
-	err := yaml.Unmarshal(input, &validSpec)
-	if err != nil {
-		return nil, err
-	}
-	return validSpec, nil
-}
@@ -0,0 +1,109 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package tuningconfigs
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sigs.k8s.io/yaml"
+)
+
+// tunedSpec mirrors the subset of the Node Tuning Operator 'Tuned' spec that can be
+// configured with the 'profile' and 'recommend' attributes.
+type tunedSpec struct {
+	Profile   []tunedProfile   `json:"profile,omitempty"`
+	Recommend []tunedRecommend `json:"recommend,omitempty"`
+}
+
+type tunedProfile struct {
+	Name string `json:"name"`
+	Data string `json:"data"`
+}
+
+type tunedRecommend struct {
+	Priority int64  `json:"priority"`
+	Profile  string `json:"profile"`
+}
+
+// hasStructuredSpec returns true if the spec is given with the 'profile' and 'recommend'
+// attributes instead of the 'spec' string.
+func hasStructuredSpec(state *TuningConfig) bool {
+	return state.Profile != nil || state.Recommend != nil
+}
+
+// structuredSpec builds the spec that is sent to OCM from the 'profile' and 'recommend'
+// attributes.
+func structuredSpec(state *TuningConfig) (map[string]any, error) {
+	spec := tunedSpec{}
+	for _, profile := range state.Profile {
+		spec.Profile = append(spec.Profile, tunedProfile{
+			Name: profile.Name.ValueString(),
+			Data: profile.Data.ValueString(),
+		})
+	}
+	for _, recommend := range state.Recommend {
+		spec.Recommend = append(spec.Recommend, tunedRecommend{
+			Priority: recommend.Priority.ValueInt64(),
+			Profile:  recommend.Profile.ValueString(),
+		})
+	}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	return parseInputString(data)
+}
+
+// populateStructuredSpec sets the 'profile' and 'recommend' attributes from the spec
+// returned by OCM. Fields that can't be represented by those attributes are ignored.
+func populateStructuredSpec(spec any, state *TuningConfig) error {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return err
+	}
+	parsed := tunedSpec{}
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return err
+	}
+	state.Profile = []*TunedProfile{}
+	for _, profile := range parsed.Profile {
+		state.Profile = append(state.Profile, &TunedProfile{
+			Name: types.StringValue(profile.Name),
+			Data: types.StringValue(profile.Data),
+		})
+	}
+	state.Recommend = []*TunedRecommend{}
+	for _, recommend := range parsed.Recommend {
+		state.Recommend = append(state.Recommend, &TunedRecommend{
+			Priority: types.Int64Value(recommend.Priority),
+			Profile:  types.StringValue(recommend.Profile),
+		})
+	}
+	return nil
+}
+
+// specsAreEqual checks if two JSON or YAML documents contain the same spec, ignoring
+// formatting and the order of the keys.
+func specsAreEqual(spec1, spec2 string) bool {
+	parsedSpec1, err := parseInputString([]byte(spec1))
+	if err != nil {
+		return false
+	}
+	parsedSpec2, err := parseInputString([]byte(spec2))
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(parsedSpec1, parsedSpec2)
+}
+
+func parseInputString(input []byte) (map[string]any, error) {
+	var validSpec map[string]any
+	err := yaml.Unmarshal(input, &validSpec)
+	if err != nil {
+		return nil, err
+	}
+	return validSpec, nil
+}
@@ -0,0 +1,38 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package tuningconfigs
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// specSemanticEquality returns a plan modifier that keeps the spec stored in the state when the
+// planned spec only differs from it in formatting or in the order of the keys.
+func specSemanticEquality() planmodifier.String {
+	return specSemanticEqualityModifier{}
+}
+
+type specSemanticEqualityModifier struct{}
+
+func (m specSemanticEqualityModifier) Description(_ context.Context) string {
+	return "Ignores changes to the spec that don't change its content."
+}
+
+func (m specSemanticEqualityModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m specSemanticEqualityModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+	if req.PlanValue.Equal(req.StateValue) {
+		return
+	}
+	if specsAreEqual(req.StateValue.ValueString(), req.PlanValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
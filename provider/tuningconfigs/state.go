@@ -3,11 +3,28 @@
 
 package tuningconfigs
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 type TuningConfig struct {
-	Id      types.String `tfsdk:"id"`
-	Name    types.String `tfsdk:"name"`
-	Cluster types.String `tfsdk:"cluster"`
-	Spec    types.String `tfsdk:"spec"`
+	Id        types.String      `tfsdk:"id"`
+	Name      types.String      `tfsdk:"name"`
+	Cluster   types.String      `tfsdk:"cluster"`
+	Spec      types.String      `tfsdk:"spec"`
+	Profile   []*TunedProfile   `tfsdk:"profile"`
+	Recommend []*TunedRecommend `tfsdk:"recommend"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type TunedProfile struct {
+	Name types.String `tfsdk:"name"`
+	Data types.String `tfsdk:"data"`
+}
+
+type TunedRecommend struct {
+	Priority types.Int64  `tfsdk:"priority"`
+	Profile  types.String `tfsdk:"profile"`
 }
@@ -0,0 +1,386 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hcp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/hcp/upgrade"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common/attrvalidators"
+)
+
+type UpgradePolicyResource struct {
+	collection  *cmv1.ClustersClient
+	clusterWait common.ClusterWait
+}
+
+func New() resource.Resource {
+	return &UpgradePolicyResource{}
+}
+
+var _ resource.Resource = &UpgradePolicyResource{}
+var _ resource.ResourceWithImportState = &UpgradePolicyResource{}
+var _ resource.ResourceWithConfigure = &UpgradePolicyResource{}
+
+func (r *UpgradePolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hcp_cluster_upgrade_policy"
+}
+
+func (r *UpgradePolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Recurring automatic upgrade policy of the control plane of a ROSA HCP cluster. " +
+			"OCM selects the target version of every run, so the `version` attribute of the cluster " +
+			"does not need to be bumped to keep the cluster patched. A cluster can only have one recurring upgrade policy.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Identifier of the cluster." + common.ValueCannotBeChangedStringDescription,
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`), "cluster ID may not be empty/blank string"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the upgrade policy.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Description: "Cron expression, in UTC, of the start time of the upgrade windows, " +
+					"for example '0 2 * * 0' to start upgrades every Sunday at 02:00. It is the only setting of the " +
+					"windows: there is no separate first run or end time, and an upgrade that starts in a window " +
+					"runs until it completes.",
+				Required: true,
+				Validators: []validator.String{
+					attrvalidators.CronValidator(),
+				},
+			},
+			"enable_minor_version_upgrades": schema.BoolAttribute{
+				Description: "Allow automatic upgrades to new minor (y-stream) versions. " +
+					"When false, only patch (z-stream) upgrades are applied. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"next_run": schema.StringAttribute{
+				Description: "Start time, in RFC3339 format, of the next upgrade window, calculated by OCM from the `schedule`.",
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the upgrade policy.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true}),
+		},
+	}
+}
+
+func (r *UpgradePolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*common.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	connection := providerData.Connection
+
+	r.collection = connection.ClustersMgmt().V1().Clusters()
+	r.clusterWait = common.NewClusterWait(r.collection, connection,
+		common.WithPollingInterval(providerData.ClusterWaitPollingInterval))
+}
+
+func (r *UpgradePolicyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	plan := &UpgradePolicyState{}
+	diags := request.Plan.Get(ctx, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	clusterId := plan.Cluster.ValueString()
+
+	// Wait till the cluster is ready:
+	waitTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultClusterReadyTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	cluster, err := r.clusterWait.WaitForClusterToBeReady(ctx, clusterId, common.DurationToMinutes(waitTimeout))
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Cannot poll cluster state",
+			fmt.Sprintf(
+				"Cannot poll state of cluster with identifier '%s': %v",
+				clusterId, err,
+			),
+		)
+		return
+	}
+	if !cluster.Hypershift().Enabled() {
+		response.Diagnostics.AddError(
+			"Unsupported Cluster Type",
+			"Recurring control plane upgrade policies are only supported on Hosted Control Plane clusters",
+		)
+		return
+	}
+
+	upgrades, err := upgrade.GetScheduledUpgrades(ctx, r.collection, clusterId)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Failed getting upgrade policies",
+			fmt.Sprintf(
+				"Failed getting upgrade policies for cluster '%s': %v",
+				clusterId, err,
+			),
+		)
+		return
+	}
+	if existing := upgrade.FindRecurringUpgrade(upgrades); existing != nil {
+		response.Diagnostics.AddError(
+			"Recurring upgrade policy already exists",
+			fmt.Sprintf(
+				"Cluster '%s' already has the recurring upgrade policy '%s'. "+
+					"Import it with 'terraform import' using the cluster identifier to manage it.",
+				clusterId, existing.Policy.ID(),
+			),
+		)
+		return
+	}
+
+	policy, err := cmv1.NewControlPlaneUpgradePolicy().
+		UpgradeType(cmv1.UpgradeTypeControlPlane).
+		ScheduleType(cmv1.ScheduleTypeAutomatic).
+		Schedule(plan.Schedule.ValueString()).
+		EnableMinorVersionUpgrades(plan.EnableMinorVersionUpgrades.ValueBool()).
+		Build()
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Failed building upgrade policy",
+			fmt.Sprintf(
+				"Failed building upgrade policy for cluster '%s': %v",
+				clusterId, err,
+			),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Creating recurring upgrade policy", map[string]any{
+		"cluster":  clusterId,
+		"schedule": plan.Schedule.ValueString(),
+	})
+	add, err := r.collection.Cluster(clusterId).ControlPlane().UpgradePolicies().
+		Add().Body(policy).SendContext(ctx)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Failed creating upgrade policy",
+			fmt.Sprintf(
+				"Failed creating upgrade policy for cluster '%s': %v",
+				clusterId, err,
+			),
+		)
+		return
+	}
+
+	populateUpgradePolicyState(add.Body(), add.Body().State(), plan)
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *UpgradePolicyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	state := &UpgradePolicyState{}
+	diags := request.State.Get(ctx, state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	clusterId := state.Cluster.ValueString()
+
+	upgrades, err := upgrade.GetScheduledUpgrades(ctx, r.collection, clusterId)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Failed getting upgrade policies",
+			fmt.Sprintf(
+				"Failed getting upgrade policies for cluster '%s': %v",
+				clusterId, err,
+			),
+		)
+		return
+	}
+
+	// The identifier is unknown right after an import, in that case the
+	// recurring policy of the cluster is adopted.
+	recurring := upgrade.FindRecurringUpgrade(upgrades)
+	if recurring == nil || (common.HasValue(state.ID) && recurring.Policy.ID() != state.ID.ValueString()) {
+		tflog.Warn(ctx, fmt.Sprintf("recurring upgrade policy '%s' for cluster (%s) not found, removing from state",
+			state.ID.ValueString(), clusterId,
+		))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if common.HasValue(state.Schedule) {
+		drift := upgrade.RecurringUpgradeDrift(*recurring, state.Schedule.ValueString(),
+			state.EnableMinorVersionUpgrades.ValueBool())
+		if len(drift) > 0 {
+			response.Diagnostics.AddWarning(
+				"Recurring upgrade policy changed outside Terraform",
+				fmt.Sprintf(
+					"The attributes %s of the recurring upgrade policy '%s' of cluster '%s' were changed outside "+
+						"Terraform. The next apply will set them back to the configured values.",
+					strings.Join(drift, ", "), recurring.Policy.ID(), clusterId,
+				),
+			)
+		}
+	}
+
+	populateUpgradePolicyState(recurring.Policy, recurring.PolicyState, state)
+	diags = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *UpgradePolicyResource) Update(ctx context.Context, request resource.UpdateRequest,
+	response *resource.UpdateResponse) {
+	state := &UpgradePolicyState{}
+	diags := request.State.Get(ctx, state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plan := &UpgradePolicyState{}
+	diags = request.Plan.Get(ctx, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	clusterId := state.Cluster.ValueString()
+
+	patch, err := cmv1.NewControlPlaneUpgradePolicy().
+		ScheduleType(cmv1.ScheduleTypeAutomatic).
+		Schedule(plan.Schedule.ValueString()).
+		EnableMinorVersionUpgrades(plan.EnableMinorVersionUpgrades.ValueBool()).
+		Build()
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Failed building upgrade policy",
+			fmt.Sprintf(
+				"Failed building upgrade policy update for cluster '%s': %v",
+				clusterId, err,
+			),
+		)
+		return
+	}
+
+	policyClient := r.collection.Cluster(clusterId).ControlPlane().UpgradePolicies().
+		ControlPlaneUpgradePolicy(state.ID.ValueString())
+	update, err := policyClient.Update().Body(patch).SendContext(ctx)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Failed updating upgrade policy",
+			fmt.Sprintf(
+				"Failed updating upgrade policy '%s' for cluster '%s': %v",
+				state.ID.ValueString(), clusterId, err,
+			),
+		)
+		return
+	}
+
+	populateUpgradePolicyState(update.Body(), update.Body().State(), plan)
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *UpgradePolicyResource) Delete(ctx context.Context, request resource.DeleteRequest,
+	response *resource.DeleteResponse) {
+	state := &UpgradePolicyState{}
+	diags := request.State.Get(ctx, state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := r.collection.Cluster(state.Cluster.ValueString()).ControlPlane().UpgradePolicies().
+		ControlPlaneUpgradePolicy(state.ID.ValueString()).Delete().SendContext(ctx)
+	if err != nil && resp.Status() != http.StatusNotFound {
+		response.Diagnostics.AddError(
+			"Failed deleting upgrade policy",
+			fmt.Sprintf(
+				"Failed deleting upgrade policy '%s' for cluster '%s': %v",
+				state.ID.ValueString(), state.Cluster.ValueString(), err,
+			),
+		)
+		return
+	}
+
+	response.State.RemoveResource(ctx)
+}
+
+func (r *UpgradePolicyResource) ImportState(ctx context.Context, request resource.ImportStateRequest,
+	response *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "begin importstate()")
+
+	resource.ImportStatePassthroughID(ctx, path.Root("cluster"), request, response)
+}
+
+// populateUpgradePolicyState copies the data from the API object to the Terraform state.
+func populateUpgradePolicyState(object *cmv1.ControlPlaneUpgradePolicy, policyState *cmv1.UpgradePolicyState,
+	state *UpgradePolicyState) {
+	state.ID = types.StringValue(object.ID())
+	state.Schedule = types.StringValue(object.Schedule())
+	state.EnableMinorVersionUpgrades = types.BoolValue(object.EnableMinorVersionUpgrades())
+
+	if nextRun, ok := object.GetNextRun(); ok {
+		state.NextRun = types.StringValue(nextRun.UTC().Format(time.RFC3339))
+	} else {
+		state.NextRun = types.StringNull()
+	}
+
+	if value, ok := policyState.GetValue(); ok {
+		state.State = types.StringValue(string(value))
+	} else {
+		state.State = types.StringNull()
+	}
+}
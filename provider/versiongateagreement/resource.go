@@ -0,0 +1,246 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package versiongateagreement
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	// Gate agreements belong to the cluster whatever its topology, so the
+	// helpers of classic clusters work for all of them
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/classic/upgrade"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+type VersionGateAgreementResource struct {
+	collection *cmv1.ClustersClient
+}
+
+func New() resource.Resource {
+	return &VersionGateAgreementResource{}
+}
+
+var _ resource.Resource = &VersionGateAgreementResource{}
+var _ resource.ResourceWithImportState = &VersionGateAgreementResource{}
+var _ resource.ResourceWithConfigure = &VersionGateAgreementResource{}
+
+func (r *VersionGateAgreementResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_version_gate_agreement"
+}
+
+func (r *VersionGateAgreementResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Agreement to a version gate of a cluster. Upgrades of the cluster and of its machine pools " +
+			"don't require `upgrade_acknowledgements_for` for the gates that already have an agreement. " +
+			"The gates that an upgrade needs are listed by the `rhcs_cluster_upgrades` data source.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Identifier of the cluster." + common.ValueCannotBeChangedStringDescription,
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`), "cluster ID may not be empty/blank string"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the version gate agreement.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version_gate": schema.StringAttribute{
+				Description: "Identifier of the version gate." + common.ValueCannotBeChangedStringDescription,
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`), "version gate ID may not be empty/blank string"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version_raw_id_prefix": schema.StringAttribute{
+				Description: "Versions that the gate applies to, for example '4.16'.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "Label of the version gate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of what was acknowledged.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"documentation_url": schema.StringAttribute{
+				Description: "URL of the documentation of the version gate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VersionGateAgreementResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*common.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	connection := providerData.Connection
+
+	r.collection = connection.ClustersMgmt().V1().Clusters()
+}
+
+func (r *VersionGateAgreementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &VersionGateAgreementState{}
+	diags := req.Plan.Get(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clusterId := plan.Cluster.ValueString()
+	gateId := plan.VersionGate.ValueString()
+
+	tflog.Debug(ctx, "Acknowledging version gate", map[string]any{
+		"cluster": clusterId,
+		"gateID":  gateId,
+	})
+	agreement, err := upgrade.AckVersionGate(r.collection.Cluster(clusterId).GateAgreements(), gateId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't acknowledge version gate",
+			fmt.Sprintf("Can't acknowledge version gate '%s' for cluster '%s': %v", gateId, clusterId, err),
+		)
+		return
+	}
+
+	populateState(agreement, plan)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *VersionGateAgreementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &VersionGateAgreementState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clusterId := state.Cluster.ValueString()
+	agreementId := state.ID.ValueString()
+
+	getResp, err := r.collection.Cluster(clusterId).GateAgreements().VersionGateAgreement(agreementId).Get().
+		SendContext(ctx)
+	if err != nil {
+		if getResp != nil && getResp.Status() == http.StatusNotFound {
+			tflog.Warn(ctx, "Version gate agreement not found, removing from state", map[string]any{
+				"cluster":   clusterId,
+				"agreement": agreementId,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Can't get version gate agreement",
+			fmt.Sprintf("Can't get version gate agreement '%s' for cluster '%s': %v", agreementId, clusterId, err),
+		)
+		return
+	}
+
+	populateState(getResp.Body(), state)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *VersionGateAgreementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All the attributes that can be set require replacement, so there is nothing to update.
+	plan := &VersionGateAgreementState{}
+	diags := req.Plan.Get(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *VersionGateAgreementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &VersionGateAgreementState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clusterId := state.Cluster.ValueString()
+	agreementId := state.ID.ValueString()
+
+	deleteResp, err := r.collection.Cluster(clusterId).GateAgreements().VersionGateAgreement(agreementId).Delete().
+		SendContext(ctx)
+	if err != nil && (deleteResp == nil || deleteResp.Status() != http.StatusNotFound) {
+		resp.Diagnostics.AddError(
+			"Can't delete version gate agreement",
+			fmt.Sprintf("Can't delete version gate agreement '%s' for cluster '%s': %v", agreementId, clusterId, err),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *VersionGateAgreementResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import identifier",
+			"Expected import identifier with format: cluster_id,version_gate_agreement_id",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+func populateState(agreement *cmv1.VersionGateAgreement, state *VersionGateAgreementState) {
+	gate := agreement.VersionGate()
+	state.ID = types.StringValue(agreement.ID())
+	state.VersionGate = types.StringValue(gate.ID())
+	state.VersionRawIDPrefix = types.StringValue(gate.VersionRawIDPrefix())
+	state.Label = types.StringValue(gate.Label())
+	state.Description = types.StringValue(gate.Description())
+	state.DocumentationURL = types.StringValue(gate.DocumentationURL())
+}
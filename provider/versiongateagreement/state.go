@@ -0,0 +1,18 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package versiongateagreement
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type VersionGateAgreementState struct {
+	Cluster            types.String `tfsdk:"cluster"`
+	ID                 types.String `tfsdk:"id"`
+	VersionGate        types.String `tfsdk:"version_gate"`
+	VersionRawIDPrefix types.String `tfsdk:"version_raw_id_prefix"`
+	Label              types.String `tfsdk:"label"`
+	Description        types.String `tfsdk:"description"`
+	DocumentationURL   types.String `tfsdk:"documentation_url"`
+}
@@ -477,6 +477,7 @@ var _ = Describe("Cluster Autoscaler", func() {
 						"delay_after_delete":    nil,
 						"delay_after_failure":   nil,
 					},
+					"timeouts": nil,
 				},
 			))
 		})
@@ -646,6 +647,7 @@ var _ = Describe("Cluster Autoscaler", func() {
 					"balancing_ignored_labels":      nil,
 					"resource_limits":               nil,
 					"scale_down":                    nil,
+					"timeouts":                      nil,
 				},
 			))
 		})
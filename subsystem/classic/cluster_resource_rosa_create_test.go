@@ -1124,6 +1124,14 @@ var _ = Describe("rhcs_cluster_rosa_classic - create", func() {
 					  "value": "error"
 					}]`),
 					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/inflight_checks"),
+						RespondWithJSON(http.StatusOK, `{"kind": "InflightCheckList", "items": []}`),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/logs/install"),
+						RespondWithJSON(http.StatusOK, `{"kind": "Log", "id": "install", "content": ""}`),
+					),
 				)
 
 				// Run the apply command:
@@ -1191,6 +1191,13 @@ var _ = Describe("rhcs_cluster_rosa_classic - upgrade", func() {
 				)
 			})
 			It("Fails upgrade for un-acked gates", func() {
+				// No gate was acknowledged beforehand
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/gate_agreements"),
+						RespondWithJSON(http.StatusOK, `{"page": 1, "size": 0, "total": 0, "items": []}`),
+					),
+				)
 				Terraform.Source(`
 			resource "rhcs_cluster_rosa_classic" "my_cluster" {
 				name           = "my-cluster"
@@ -1210,6 +1217,13 @@ var _ = Describe("rhcs_cluster_rosa_classic - upgrade", func() {
 				Expect(Terraform.Apply()).NotTo(BeZero())
 			})
 			It("Fails upgrade if wrong version is acked", func() {
+				// No gate was acknowledged beforehand
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/gate_agreements"),
+						RespondWithJSON(http.StatusOK, `{"page": 1, "size": 0, "total": 0, "items": []}`),
+					),
+				)
 				Terraform.Source(`
 			resource "rhcs_cluster_rosa_classic" "my_cluster" {
 				name           = "my-cluster"
@@ -1309,4 +1323,210 @@ var _ = Describe("rhcs_cluster_rosa_classic - upgrade", func() {
 			})
 		})
 	})
+
+	Context("rhcs_cluster_rosa_classic - maintenance window", func() {
+		It("fails if both upgrade_next_run and upgrade_schedule are set", func() {
+			Terraform.Source(`
+		  resource "rhcs_cluster_rosa_classic" "my_cluster" {
+			name           = "my-cluster"
+			cloud_region   = "us-west-1"
+			aws_account_id = "123456789012"
+			sts = {
+				operator_role_prefix = "test"
+				role_arn = "",
+				support_role_arn = "",
+				instance_iam_roles = {
+					master_role_arn = "",
+					worker_role_arn = "",
+				}
+			}
+			version          = "4.10.1"
+			upgrade_next_run = "2099-01-01T00:00:00Z"
+			upgrade_schedule = "0 22 * * 6"
+		}`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("Invalid Attribute Combination")
+		})
+
+		It("fails if upgrade_schedule is not a valid cron expression", func() {
+			Terraform.Source(`
+		  resource "rhcs_cluster_rosa_classic" "my_cluster" {
+			name           = "my-cluster"
+			cloud_region   = "us-west-1"
+			aws_account_id = "123456789012"
+			sts = {
+				operator_role_prefix = "test"
+				role_arn = "",
+				support_role_arn = "",
+				instance_iam_roles = {
+					master_role_arn = "",
+					worker_role_arn = "",
+				}
+			}
+			version          = "4.10.0"
+			upgrade_schedule = "every saturday"
+		}`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("Invalid cron expression")
+		})
+
+		It("Schedules the upgrade at upgrade_next_run", func() {
+			TestServer.AppendHandlers(
+				// Refresh cluster state
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithJSON(http.StatusOK, template),
+				),
+				// Get cluster info for upgrade validation
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithJSON(http.StatusOK, template),
+				),
+				// Validate upgrade versions
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.10.1"),
+					RespondWithJSON(http.StatusOK, v4_10_1Info),
+				),
+				// Look for existing upgrade policies
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, upgradePoliciesEmpty),
+				),
+				// Look for gate agreements by posting an upgrade policy w/ dryRun (no gates necessary)
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies", "dryRun=true"),
+					VerifyJQ(".version", "4.10.1"),
+					RespondWithJSON(http.StatusNoContent, ""),
+				),
+				// Create an upgrade policy in the requested window
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies"),
+					VerifyJQ(".version", "4.10.1"),
+					VerifyJQ(".next_run", "2099-01-01T00:00:00Z"),
+					RespondWithJSON(http.StatusCreated, `
+				{
+					"kind": "UpgradePolicy",
+					"id": "123",
+					"href": "/api/clusters_mgmt/v1/clusters/123/upgrade_policies/123",
+					"schedule_type": "manual",
+					"upgrade_type": "OSD",
+					"version": "4.10.1",
+					"next_run": "2099-01-01T00:00:00Z",
+					"cluster_id": "123",
+					"enable_minor_version_upgrades": true
+				}`),
+				),
+				// Patch the cluster (w/ no changes)
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithPatchedJSON(http.StatusCreated, template, `[
+					{
+					  "op": "add",
+					  "path": "/properties",
+					  "value": {
+						"rosa_tf_commit": "123",
+						"rosa_tf_version": "123"
+					  }
+					}
+				]`),
+				),
+			)
+			Terraform.Source(`
+		  resource "rhcs_cluster_rosa_classic" "my_cluster" {
+			name           = "my-cluster"
+			cloud_region   = "us-west-1"
+			aws_account_id = "123456789012"
+			sts = {
+				operator_role_prefix = "test"
+				role_arn = "",
+				support_role_arn = "",
+				instance_iam_roles = {
+					master_role_arn = "",
+					worker_role_arn = "",
+				}
+			}
+			version          = "4.10.1"
+			upgrade_next_run = "2099-01-01T00:00:00Z"
+		}`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			resource := Terraform.Resource("rhcs_cluster_rosa_classic", "my_cluster")
+			Expect(resource).To(MatchJQ(".attributes.upgrade_next_run", "2099-01-01T00:00:00Z"))
+		})
+
+		It("Creates a recurring upgrade policy for upgrade_schedule", func() {
+			TestServer.AppendHandlers(
+				// Refresh cluster state
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithJSON(http.StatusOK, template),
+				),
+				// Look for an existing recurring upgrade policy
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, upgradePoliciesEmpty),
+				),
+				// Create the recurring upgrade policy
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies"),
+					VerifyJQ(".schedule_type", "automatic"),
+					VerifyJQ(".schedule", "0 22 * * 6"),
+					VerifyJQ(".upgrade_type", "OSD"),
+					RespondWithJSON(http.StatusCreated, `
+				{
+					"kind": "UpgradePolicy",
+					"id": "789",
+					"href": "/api/clusters_mgmt/v1/clusters/123/upgrade_policies/789",
+					"schedule_type": "automatic",
+					"schedule": "0 22 * * 6",
+					"upgrade_type": "OSD",
+					"next_run": "2099-01-03T22:00:00Z",
+					"cluster_id": "123"
+				}`),
+				),
+				// Look for pinned upgrades to cancel
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, upgradePoliciesEmpty),
+				),
+				// Patch the cluster (w/ no changes)
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithPatchedJSON(http.StatusCreated, template, `[
+					{
+					  "op": "add",
+					  "path": "/properties",
+					  "value": {
+						"rosa_tf_commit": "123",
+						"rosa_tf_version": "123"
+					  }
+					}
+				]`),
+				),
+			)
+			Terraform.Source(`
+		  resource "rhcs_cluster_rosa_classic" "my_cluster" {
+			name           = "my-cluster"
+			cloud_region   = "us-west-1"
+			aws_account_id = "123456789012"
+			sts = {
+				operator_role_prefix = "test"
+				role_arn = "",
+				support_role_arn = "",
+				instance_iam_roles = {
+					master_role_arn = "",
+					worker_role_arn = "",
+				}
+			}
+			version          = "4.10.0"
+			upgrade_schedule = "0 22 * * 6"
+		}`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			resource := Terraform.Resource("rhcs_cluster_rosa_classic", "my_cluster")
+			Expect(resource).To(MatchJQ(".attributes.upgrade_schedule", "0 22 * * 6"))
+		})
+	})
 })
@@ -88,6 +88,10 @@ var _ = Describe("Cluster creation", func() {
 	  "name": "my-cluster",
 	  "domain_prefix": "my-cluster",
 	  "state": "error",
+	  "status": {
+	    "provision_error_code": "OCM3055",
+	    "provision_error_message": "Install failed"
+	  },
 	  "region": {
 	    "id": "us-west-1"
 	  },
@@ -207,6 +211,41 @@ var _ = Describe("Cluster creation", func() {
 				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
 				RespondWithJSON(http.StatusOK, templateErrorState),
 			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/inflight_checks"),
+				RespondWithJSON(http.StatusOK, `{
+				  "kind": "InflightCheckList",
+				  "page": 1,
+				  "size": 2,
+				  "total": 2,
+				  "items": [
+				    {
+				      "kind": "InflightCheck",
+				      "id": "1",
+				      "name": "egress",
+				      "state": "failed",
+				      "details": {
+				        "subnet-123": "egress to quay.io:443 blocked"
+				      }
+				    },
+				    {
+				      "kind": "InflightCheck",
+				      "id": "2",
+				      "name": "sts",
+				      "state": "passed"
+				    }
+				  ]
+				}`),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/logs/install"),
+				VerifyFormKV("tail", "50"),
+				RespondWithJSON(http.StatusOK, `{
+				  "kind": "Log",
+				  "id": "install",
+				  "content": "level=error msg=\"Cluster operator ingress is not available\""
+				}`),
+			),
 		)
 
 		Terraform.Source(`
@@ -218,7 +257,10 @@ var _ = Describe("Cluster creation", func() {
 
 		runOutput := Terraform.Apply()
 		Expect(runOutput.ExitCode).ToNot(BeZero())
-		runOutput.VerifyErrorContainsSubstring("Cluster '123' is in state 'error' and will not become ready")
+		runOutput.VerifyErrorContainsSubstring("Cluster '123' is in state 'error' and will not become ready: OCM3055: Install failed")
+		runOutput.VerifyErrorContainsSubstring("Inflight check 'egress' failed")
+		runOutput.VerifyErrorContainsSubstring("egress to quay.io:443 blocked")
+		runOutput.VerifyErrorContainsSubstring("Cluster operator ingress is not available")
 		resource := Terraform.Resource("rhcs_cluster_wait", "rosa_cluster")
 		Expect(resource).To(MatchJQ(`.attributes.ready`, false))
 	})
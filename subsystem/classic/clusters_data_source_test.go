@@ -0,0 +1,123 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package classic
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Clusters data source", func() {
+	clusters := `{
+	  "page": 1,
+	  "size": 2,
+	  "total": 2,
+	  "items": [
+	    {
+	      "id": "123",
+	      "name": "prod-1",
+	      "state": "ready",
+	      "openshift_version": "4.16.1",
+	      "region": {"id": "us-east-1"},
+	      "api": {"url": "https://api.prod-1.example.com:6443"},
+	      "hypershift": {"enabled": true},
+	      "properties": {"owner": "team-a"},
+	      "aws": {"tags": {"cost-center": "123"}}
+	    },
+	    {
+	      "id": "456",
+	      "name": "prod-2",
+	      "state": "ready",
+	      "openshift_version": "4.16.2",
+	      "region": {"id": "us-east-1"},
+	      "api": {"url": "https://api.prod-2.example.com:6443"},
+	      "properties": {"owner": "team-b"}
+	    }
+	  ]
+	}`
+
+	It("Can list clusters", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters"),
+				RespondWithJSON(http.StatusOK, clusters),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_clusters" "my_clusters" {
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_clusters", "my_clusters")
+		Expect(resource).To(MatchJQ(`.attributes.items | length`, 2))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].id`, "123"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].name`, "prod-1"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].state`, "ready"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].version`, "4.16.1"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].api_url`, "https://api.prod-1.example.com:6443"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].topology`, "hcp"))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].id`, "456"))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].topology`, "classic"))
+	})
+
+	It("Can filter clusters", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters"),
+				VerifyFormKV("search", "(product.id = 'rosa') and name like 'prod-%' and "+
+					"region.id = 'us-east-1' and hypershift.enabled = 'true' and state = 'ready' and "+
+					"openshift_version like '4.16.%'"),
+				RespondWithJSON(http.StatusOK, clusters),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_clusters" "my_clusters" {
+		    search       = "product.id = 'rosa'"
+		    name_pattern = "prod-*"
+		    region       = "us-east-1"
+		    topology     = "hcp"
+		    state        = "ready"
+		    version      = "4.16.*"
+		    properties   = {
+		      owner = "team-a"
+		    }
+		    tags = {
+		      cost-center = "123"
+		    }
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_clusters", "my_clusters")
+		Expect(resource).To(MatchJQ(`.attributes.items | length`, 1))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].id`, "123"))
+	})
+
+	It("Rejects an unknown topology", func() {
+		Terraform.Source(`
+		  data "rhcs_clusters" "my_clusters" {
+		    topology = "other"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+	})
+})
@@ -225,6 +225,25 @@ var _ = Describe("Identity provider creation", func() {
 				Expect(runOutput.ExitCode).ToNot(BeZero())
 				runOutput.VerifyErrorContainsSubstring("Attribute htpasswd.users[0].password password must contain uppercase characters")
 			})
+			It("Can't create a 'htpasswd' identity provider. both password and password_wo", func() {
+				// Run the apply command:
+				Terraform.Source(`
+	    	      resource "rhcs_identity_provider" "my_idp" {
+	    	        cluster = "123"
+	    	        name    = "my-ip"
+	    	        htpasswd = {
+                      users = [{
+	    	            username    = "my-user"
+	    	            password    = "` + htpasswdValidPass + `"
+	    	            password_wo = "` + htpasswdValidPass + `"
+                      }]
+	    	        }
+	    	      }
+	    	    `)
+				runOutput := Terraform.Apply()
+				Expect(runOutput.ExitCode).ToNot(BeZero())
+				runOutput.VerifyErrorContainsSubstring("Invalid Attribute Combination")
+			})
 		})
 	})
 
@@ -298,6 +317,50 @@ var _ = Describe("Identity provider creation", func() {
 			Expect(runOutput.ExitCode).To(BeZero())
 		})
 
+		It("Can create a 'htpasswd' identity provider with a write-only password", func() {
+			// Prepare the server:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(
+						http.MethodPost,
+						"/api/clusters_mgmt/v1/clusters/123/identity_providers",
+					),
+					VerifyJQ(".htpasswd.users.items[0].hashed_password", hashedPass),
+					RespondWithJSON(http.StatusOK, `{
+			    	  "id": "456",
+			    	  "name": "my-ip",
+                      "mapping_method": "claim",
+			    	  "htpasswd": {
+                        "users": {"items":[{"username": "my-user"}]}
+			    	  }
+			    	}`),
+				),
+			)
+
+			// Run the apply command:
+			Terraform.Source(`
+	    	  resource "rhcs_identity_provider" "my_idp" {
+	    	    cluster = "123"
+	    	    name    = "my-ip"
+	    	    htpasswd = {
+                  users = [{
+	    	        username            = "my-user"
+	    	        password_wo         = "` + htpasswdValidPass + `"
+	    	        password_wo_version = 1
+                  }]
+	    	    }
+	    	  }
+	    	`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			// The password must not be stored in the state:
+			resource := Terraform.Resource("rhcs_identity_provider", "my_idp")
+			Expect(resource).To(MatchJQ(".attributes.htpasswd.users[0].password", nil))
+			Expect(resource).To(MatchJQ(".attributes.htpasswd.users[0].password_wo", nil))
+			Expect(resource).To(MatchJQ(".attributes.htpasswd.users[0].password_wo_version", 1.0))
+		})
+
 		It("Reconcile an 'htpasswd' identity provider, when state exists but 404 from server", func() {
 			// Prepare the server:
 			TestServer.AppendHandlers(
@@ -658,6 +721,192 @@ var _ = Describe("Identity provider creation", func() {
 			})
 		})
 
+		Context("Update a non 'htpasswd' identity provider", func() {
+			BeforeEach(func() {
+				// Prepare the server:
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(
+							http.MethodPost,
+							"/api/clusters_mgmt/v1/clusters/123/identity_providers",
+						),
+						RespondWithJSON(http.StatusOK, `{
+    				      "id": "456",
+    				      "name": "my-ip",
+                          "mapping_method": "claim",
+    				      "github": {
+    				        "client_id": "test-client",
+    				        "client_secret": "test-secret",
+                            "organizations": ["my-org"]
+    				      }
+    				    }`),
+					),
+				)
+
+				// Run the apply command:
+				Terraform.Source(`
+    		      resource "rhcs_identity_provider" "my_idp" {
+    		        cluster = "123"
+    		        name    = "my-ip"
+    		        github = {
+    		    	  client_id = "test-client"
+    		    	  client_secret = "test-secret"
+                      organizations = ["my-org"]
+    		        }
+    		      }
+    		    `)
+				runOutput := Terraform.Apply()
+				Expect(runOutput.ExitCode).To(BeZero())
+			})
+
+			It("Updates the provider details in place", func() {
+				// Prepare the server:
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(
+							http.MethodGet,
+							"/api/clusters_mgmt/v1/clusters/123/identity_providers/456",
+						),
+						RespondWithJSON(http.StatusOK, `{
+    				      "id": "456",
+    				      "name": "my-ip",
+                          "mapping_method": "claim",
+    				      "github": {
+    				        "client_id": "test-client",
+    				        "client_secret": "test-secret",
+                            "organizations": ["my-org"]
+    				      }
+    				    }`),
+					),
+					CombineHandlers(
+						VerifyRequest(
+							http.MethodPatch,
+							"/api/clusters_mgmt/v1/clusters/123/identity_providers/456",
+						),
+						VerifyJSON(`{
+    				      "kind": "IdentityProvider",
+    				      "type": "GithubIdentityProvider",
+                          "mapping_method": "add",
+    				      "github": {
+    				        "client_id": "test-client",
+    				        "client_secret": "test-secret",
+                            "organizations": ["my-org", "my-other-org"]
+    				      }
+    				    }`),
+						RespondWithJSON(http.StatusOK, `{
+    				      "id": "456",
+    				      "name": "my-ip",
+                          "mapping_method": "add",
+    				      "github": {
+    				        "client_id": "test-client",
+    				        "client_secret": "test-secret",
+                            "organizations": ["my-org", "my-other-org"]
+    				      }
+    				    }`),
+					),
+				)
+
+				// Run the apply command:
+				Terraform.Source(`
+    		      resource "rhcs_identity_provider" "my_idp" {
+    		        cluster        = "123"
+    		        name           = "my-ip"
+    		        mapping_method = "add"
+    		        github = {
+    		    	  client_id = "test-client"
+    		    	  client_secret = "test-secret"
+                      organizations = ["my-org", "my-other-org"]
+    		        }
+    		      }
+    		    `)
+				runOutput := Terraform.Apply()
+				Expect(runOutput.ExitCode).To(BeZero())
+				resource := Terraform.Resource("rhcs_identity_provider", "my_idp")
+				Expect(resource).To(MatchJQ(".attributes.id", "456"))
+				Expect(resource).To(MatchJQ(".attributes.mapping_method", "add"))
+				Expect(resource).To(MatchJQ(".attributes.github.organizations | length", 2))
+			})
+
+			It("Replaces the provider when its name changes", func() {
+				// Prepare the server:
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(
+							http.MethodGet,
+							"/api/clusters_mgmt/v1/clusters/123/identity_providers/456",
+						),
+						RespondWithJSON(http.StatusOK, `{
+    				      "id": "456",
+    				      "name": "my-ip",
+                          "mapping_method": "claim",
+    				      "github": {
+    				        "client_id": "test-client",
+    				        "client_secret": "test-secret",
+                            "organizations": ["my-org"]
+    				      }
+    				    }`),
+					),
+					CombineHandlers(
+						VerifyRequest(
+							http.MethodDelete,
+							"/api/clusters_mgmt/v1/clusters/123/identity_providers/456",
+						),
+						RespondWithJSON(http.StatusNoContent, "{}"),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+						RespondWithJSON(http.StatusOK, `{
+			    	      "id": "123",
+			    	      "name": "my-cluster",
+			    	      "state": "ready"
+			    	    }`),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+						RespondWithJSON(http.StatusOK, `{
+			    	      "id": "123",
+			    	      "name": "my-cluster",
+			    	      "state": "ready"
+			    	    }`),
+					),
+					CombineHandlers(
+						VerifyRequest(
+							http.MethodPost,
+							"/api/clusters_mgmt/v1/clusters/123/identity_providers",
+						),
+						VerifyJQ(".name", "my-new-ip"),
+						RespondWithJSON(http.StatusOK, `{
+    				      "id": "457",
+    				      "name": "my-new-ip",
+                          "mapping_method": "claim",
+    				      "github": {
+    				        "client_id": "test-client",
+    				        "client_secret": "test-secret",
+                            "organizations": ["my-org"]
+    				      }
+    				    }`),
+					),
+				)
+
+				// Run the apply command:
+				Terraform.Source(`
+    		      resource "rhcs_identity_provider" "my_idp" {
+    		        cluster = "123"
+    		        name    = "my-new-ip"
+    		        github = {
+    		    	  client_id = "test-client"
+    		    	  client_secret = "test-secret"
+                      organizations = ["my-org"]
+    		        }
+    		      }
+    		    `)
+				runOutput := Terraform.Apply()
+				Expect(runOutput.ExitCode).To(BeZero())
+				resource := Terraform.Resource("rhcs_identity_provider", "my_idp")
+				Expect(resource).To(MatchJQ(".attributes.id", "457"))
+			})
+		})
+
 		Context("Can create 'LDAP' Identity provider", func() {
 			Context("Invalid LDAP config", func() {
 				It("Should fail if not both bind properties are set", func() {
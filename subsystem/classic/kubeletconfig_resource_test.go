@@ -254,6 +254,43 @@ var _ = Describe("Cluster KubeletConfig", func() {
 						}
 					`),
 				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/node_pools"),
+					RespondWithJSON(http.StatusOK, `
+						{
+							"kind": "NodePoolList",
+							"page": 1,
+							"size": 2,
+							"total": 2,
+							"items": [
+								{
+									"kind": "NodePool",
+									"id": "workers",
+									"kubelet_configs": ["my_name"]
+								},
+								{
+									"kind": "NodePool",
+									"id": "infra"
+								}
+							]
+						}
+					`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithJSON(http.StatusOK, `
+						{
+							"kind": "Cluster",
+							"id": "123",
+							"href": "/api/clusters_mgmt/v1/clusters/123",
+							"name": "cluster",
+							"state": "ready",
+							"hypershift": {
+								"enabled": true
+							}
+						}
+					`),
+				),
 				CombineHandlers(
 					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
 					RespondWithJSON(http.StatusOK, `
@@ -496,6 +533,21 @@ var _ = Describe("Cluster KubeletConfig", func() {
 						}
 					`),
 				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithJSON(http.StatusOK, `
+						{
+							"kind": "Cluster",
+							"id": "123",
+							"href": "/api/clusters_mgmt/v1/clusters/123",
+							"name": "cluster",
+							"state": "ready",
+							"hypershift": {
+								"enabled": false
+							}
+						}
+					`),
+				),
 			)
 
 			Terraform.Source(`
@@ -514,7 +566,9 @@ var _ = Describe("Cluster KubeletConfig", func() {
 					"cluster":        "123",
 					"id":             "456",
 					"name":           "my_name",
+					"node_pools":     nil,
 					"pod_pids_limit": float64(5000),
+					"timeouts":       nil,
 				},
 			))
 		})
@@ -604,6 +658,21 @@ var _ = Describe("Cluster KubeletConfig", func() {
 						}
 					`),
 				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithJSON(http.StatusOK, `
+						{
+							"kind": "Cluster",
+							"id": "123",
+							"href": "/api/clusters_mgmt/v1/clusters/123",
+							"name": "cluster",
+							"state": "ready",
+							"hypershift": {
+								"enabled": false
+							}
+						}
+					`),
+				),
 				CombineHandlers(
 					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/kubelet_configs/456"),
 					RespondWithJSON(http.StatusOK, `
@@ -629,6 +698,21 @@ var _ = Describe("Cluster KubeletConfig", func() {
 						}
 					`),
 				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithJSON(http.StatusOK, `
+						{
+							"kind": "Cluster",
+							"id": "123",
+							"href": "/api/clusters_mgmt/v1/clusters/123",
+							"name": "cluster",
+							"state": "ready",
+							"hypershift": {
+								"enabled": false
+							}
+						}
+					`),
+				),
 			)
 
 			Terraform.Source(`
@@ -649,6 +733,8 @@ var _ = Describe("Cluster KubeletConfig", func() {
 					"pod_pids_limit": float64(10000),
 					"id":             "456",
 					"name":           "my_name",
+					"node_pools":     nil,
+					"timeouts":       nil,
 				},
 			))
 		})
@@ -758,6 +844,21 @@ var _ = Describe("Cluster KubeletConfig", func() {
 						}
 					`),
 				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithJSON(http.StatusOK, `
+						{
+							"kind": "Cluster",
+							"id": "123",
+							"href": "/api/clusters_mgmt/v1/clusters/123",
+							"name": "cluster",
+							"state": "ready",
+							"hypershift": {
+								"enabled": false
+							}
+						}
+					`),
+				),
 				CombineHandlers(
 					VerifyRequest(http.MethodDelete, "/api/clusters_mgmt/v1/clusters/123/kubelet_configs/456"),
 					RespondWithJSON(http.StatusNoContent, "{}"),
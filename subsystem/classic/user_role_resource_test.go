@@ -0,0 +1,295 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package classic
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+const (
+	userRoleLinkedLabelResponse = `{
+		"kind": "Label",
+		"id": "label-user-123",
+		"key": "sts_user_role",
+		"value": "arn:aws:iam::123456789012:role/ManagedOpenShift-User-testuser-Role"
+	}`
+
+	userRoleExistingLabelListResponse = `{
+		"kind": "LabelList",
+		"page": 1,
+		"size": 1,
+		"total": 1,
+		"items": [
+			{
+				"kind": "Label",
+				"id": "label-user-123",
+				"key": "sts_user_role",
+				"value": "arn:aws:iam::123456789012:role/ManagedOpenShift-User-testuser-Role"
+			}
+		]
+	}`
+
+	userRoleTwoRolesLabelListResponse = `{
+		"kind": "LabelList",
+		"page": 1,
+		"size": 1,
+		"total": 1,
+		"items": [
+			{
+				"kind": "Label",
+				"id": "label-user-123",
+				"key": "sts_user_role",
+				"value": "arn:aws:iam::999999999999:role/other-user-role,arn:aws:iam::123456789012:role/ManagedOpenShift-User-testuser-Role"
+			}
+		]
+	}`
+
+	userRoleOtherAccountLabelListResponse = `{
+		"kind": "LabelList",
+		"page": 1,
+		"size": 1,
+		"total": 1,
+		"items": [
+			{
+				"kind": "Label",
+				"id": "label-user-123",
+				"key": "sts_user_role",
+				"value": "arn:aws:iam::999999999999:role/other-user-role"
+			}
+		]
+	}`
+)
+
+var _ = Describe("User role link resource", func() {
+
+	It("Can create and link a user role", func() {
+		TestServer.AppendHandlers(
+			// Create: resolveAccountID
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/current_account"),
+				RespondWithJSON(http.StatusOK, ocmRoleCurrentAccountResponse),
+			),
+			// Create: findLabel
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/accounts/1234567890/labels"),
+				RespondWithJSON(http.StatusOK, ocmRoleEmptyLabelsResponse),
+			),
+			// Create: POST label
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/accounts_mgmt/v1/accounts/1234567890/labels"),
+				VerifyJQ(`.key`, "sts_user_role"),
+				VerifyJQ(`.value`, "arn:aws:iam::123456789012:role/ManagedOpenShift-User-testuser-Role"),
+				RespondWithJSON(http.StatusCreated, userRoleLinkedLabelResponse),
+			),
+			// Destroy plan Read: resolveAccountID
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/current_account"),
+				RespondWithJSON(http.StatusOK, ocmRoleCurrentAccountResponse),
+			),
+			// Destroy plan Read: findLabel
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/accounts/1234567890/labels"),
+				RespondWithJSON(http.StatusOK, userRoleExistingLabelListResponse),
+			),
+			// Delete: resolveAccountID
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/current_account"),
+				RespondWithJSON(http.StatusOK, ocmRoleCurrentAccountResponse),
+			),
+			// Delete: findLabel
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/accounts/1234567890/labels"),
+				RespondWithJSON(http.StatusOK, userRoleExistingLabelListResponse),
+			),
+			// Delete: DELETE label
+			CombineHandlers(
+				VerifyRequest(http.MethodDelete, "/api/accounts_mgmt/v1/accounts/1234567890/labels/sts_user_role"),
+				RespondWithJSON(http.StatusNoContent, ""),
+			),
+		)
+
+		Terraform.Source(`
+		resource "rhcs_rosa_user_role_link" "user_role" {
+			role_arn = "arn:aws:iam::123456789012:role/ManagedOpenShift-User-testuser-Role"
+		}
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_rosa_user_role_link", "user_role")
+		Expect(resource).To(MatchJQ(".attributes.role_arn", "arn:aws:iam::123456789012:role/ManagedOpenShift-User-testuser-Role"))
+		Expect(resource).To(MatchJQ(".attributes.id", "arn:aws:iam::123456789012:role/ManagedOpenShift-User-testuser-Role"))
+		Expect(resource).To(MatchJQ(".attributes.account_id", "1234567890"))
+
+		Expect(Terraform.Destroy().ExitCode).To(BeZero())
+	})
+
+	It("Appends a user role to an existing list and removes only its own ARN", func() {
+		TestServer.AppendHandlers(
+			// Create: resolveAccountID
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/current_account"),
+				RespondWithJSON(http.StatusOK, ocmRoleCurrentAccountResponse),
+			),
+			// Create: findLabel
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/accounts/1234567890/labels"),
+				RespondWithJSON(http.StatusOK, userRoleOtherAccountLabelListResponse),
+			),
+			// Create: PATCH label
+			CombineHandlers(
+				VerifyRequest(http.MethodPatch, "/api/accounts_mgmt/v1/accounts/1234567890/labels/sts_user_role"),
+				VerifyJQ(`.value`, "arn:aws:iam::999999999999:role/other-user-role,arn:aws:iam::123456789012:role/ManagedOpenShift-User-testuser-Role"),
+				RespondWithJSON(http.StatusOK, userRoleLinkedLabelResponse),
+			),
+			// Destroy plan Read: resolveAccountID
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/current_account"),
+				RespondWithJSON(http.StatusOK, ocmRoleCurrentAccountResponse),
+			),
+			// Destroy plan Read: findLabel
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/accounts/1234567890/labels"),
+				RespondWithJSON(http.StatusOK, userRoleTwoRolesLabelListResponse),
+			),
+			// Delete: resolveAccountID
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/current_account"),
+				RespondWithJSON(http.StatusOK, ocmRoleCurrentAccountResponse),
+			),
+			// Delete: findLabel
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/accounts/1234567890/labels"),
+				RespondWithJSON(http.StatusOK, userRoleTwoRolesLabelListResponse),
+			),
+			// Delete: PATCH label with the remaining ARN
+			CombineHandlers(
+				VerifyRequest(http.MethodPatch, "/api/accounts_mgmt/v1/accounts/1234567890/labels/sts_user_role"),
+				VerifyJQ(`.value`, "arn:aws:iam::999999999999:role/other-user-role"),
+				RespondWithJSON(http.StatusOK, userRoleLinkedLabelResponse),
+			),
+		)
+
+		Terraform.Source(`
+		resource "rhcs_rosa_user_role_link" "user_role" {
+			role_arn = "arn:aws:iam::123456789012:role/ManagedOpenShift-User-testuser-Role"
+		}
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		Expect(Terraform.Destroy().ExitCode).To(BeZero())
+	})
+
+	It("Fails create when the role is already linked", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/current_account"),
+				RespondWithJSON(http.StatusOK, ocmRoleCurrentAccountResponse),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/accounts/1234567890/labels"),
+				RespondWithJSON(http.StatusOK, userRoleExistingLabelListResponse),
+			),
+		)
+
+		Terraform.Source(`
+		resource "rhcs_rosa_user_role_link" "user_role" {
+			role_arn = "arn:aws:iam::123456789012:role/ManagedOpenShift-User-testuser-Role"
+		}
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("User role already linked")
+	})
+
+	It("Rejects create when same AWS account already has a linked role", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/current_account"),
+				RespondWithJSON(http.StatusOK, ocmRoleCurrentAccountResponse),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/accounts/1234567890/labels"),
+				RespondWithJSON(http.StatusOK, userRoleExistingLabelListResponse),
+			),
+		)
+
+		Terraform.Source(`
+		resource "rhcs_rosa_user_role_link" "user_role" {
+			role_arn = "arn:aws:iam::123456789012:role/new-user-role"
+		}
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("already has a linked role")
+	})
+
+	It("Rejects ARN with commas at plan time", func() {
+		Terraform.Source(`
+		resource "rhcs_rosa_user_role_link" "user_role" {
+			role_arn = "arn:aws:iam::123456789012:role/a,arn:aws:iam::123456789012:role/b"
+		}
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("must not contain commas")
+	})
+
+	It("Can import a linked user role", func() {
+		TestServer.AppendHandlers(
+			// Import: resolveAccountID
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/current_account"),
+				RespondWithJSON(http.StatusOK, ocmRoleCurrentAccountResponse),
+			),
+			// Import: findLabel
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/accounts/1234567890/labels"),
+				RespondWithJSON(http.StatusOK, userRoleExistingLabelListResponse),
+			),
+			// Import Read: resolveAccountID
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/current_account"),
+				RespondWithJSON(http.StatusOK, ocmRoleCurrentAccountResponse),
+			),
+			// Import Read: findLabel
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/accounts/1234567890/labels"),
+				RespondWithJSON(http.StatusOK, userRoleExistingLabelListResponse),
+			),
+		)
+
+		Terraform.Source(`
+		resource "rhcs_rosa_user_role_link" "user_role" {
+			role_arn = "arn:aws:iam::123456789012:role/ManagedOpenShift-User-testuser-Role"
+		}
+		`)
+		runOutput := Terraform.Import("rhcs_rosa_user_role_link.user_role",
+			"arn:aws:iam::123456789012:role/ManagedOpenShift-User-testuser-Role")
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_rosa_user_role_link", "user_role")
+		Expect(resource).To(MatchJQ(".attributes.account_id", "1234567890"))
+	})
+})
@@ -0,0 +1,97 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package classic
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Version gate agreement", func() {
+	agreement := `{
+	  "kind": "VersionGateAgreement",
+	  "id": "888",
+	  "version_gate": {
+	    "kind": "VersionGate",
+	    "id": "999",
+	    "version_raw_id_prefix": "4.16",
+	    "label": "api.openshift.com/gate-ocp",
+	    "description": "Some APIs have been removed in OpenShift 4.16.",
+	    "documentation_url": "https://access.redhat.com/solutions/0000000"
+	  }
+	}`
+
+	It("Can create and delete an agreement", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters/123/gate_agreements"),
+				VerifyJQ(".version_gate.id", "999"),
+				RespondWithJSON(http.StatusCreated, agreement),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_version_gate_agreement" "ocp_4_16" {
+		    cluster      = "123"
+		    version_gate = "999"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_version_gate_agreement", "ocp_4_16")
+		Expect(resource).To(MatchJQ(`.attributes.id`, "888"))
+		Expect(resource).To(MatchJQ(`.attributes.version_raw_id_prefix`, "4.16"))
+		Expect(resource).To(MatchJQ(`.attributes.label`, "api.openshift.com/gate-ocp"))
+		Expect(resource).To(MatchJQ(`.attributes.documentation_url`, "https://access.redhat.com/solutions/0000000"))
+
+		// Prepare the server for the destroy:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/gate_agreements/888"),
+				RespondWithJSON(http.StatusOK, agreement),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodDelete, "/api/clusters_mgmt/v1/clusters/123/gate_agreements/888"),
+				RespondWithJSON(http.StatusNoContent, "{}"),
+			),
+		)
+		runOutput = Terraform.Destroy()
+		Expect(runOutput.ExitCode).To(BeZero())
+	})
+
+	It("Can import an agreement", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/gate_agreements/888"),
+				RespondWithJSON(http.StatusOK, agreement),
+			),
+		)
+
+		// Run the import command:
+		Terraform.Source(`
+		  resource "rhcs_version_gate_agreement" "ocp_4_16" {
+		    cluster      = "123"
+		    version_gate = "999"
+		  }
+		`)
+		runOutput := Terraform.Import("rhcs_version_gate_agreement.ocp_4_16", "123,888")
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_version_gate_agreement", "ocp_4_16")
+		Expect(resource).To(MatchJQ(`.attributes.cluster`, "123"))
+		Expect(resource).To(MatchJQ(`.attributes.version_gate`, "999"))
+	})
+})
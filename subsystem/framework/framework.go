@@ -200,6 +200,9 @@ func (b *TerraformRunnerBuilder) Build() *TerraformRunner {
 	// Enable verbose debug:
 	envMap["TF_LOG"] = "DEBUG"
 
+	// Disable the cluster cache, as the tests expect every request to reach the server:
+	envMap["RHCS_CLUSTER_CACHE_TTL"] = "0s"
+
 	// Reconstruct the environment list:
 	envList := make([]string, 0, len(envMap))
 	for name, value := range envMap {
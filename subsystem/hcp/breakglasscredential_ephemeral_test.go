@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hcp
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"    // nolint
+	. "github.com/onsi/gomega"       // nolint
+	. "github.com/onsi/gomega/ghttp" // nolint
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Ephemeral Break Glass Credential", func() {
+	Context("opening", func() {
+		It("fails if cluster is not HCP", func() {
+			cluster, err := cmv1.NewCluster().
+				ID("123").
+				Name("cluster").
+				Hypershift(cmv1.NewHypershift().Enabled(false)).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithOcmObjectMarshal(http.StatusOK, cluster, cmv1.MarshalCluster),
+				),
+			)
+
+			Terraform.Source(`
+				ephemeral "rhcs_break_glass_credential" "break_glass" {
+					cluster = "123"
+				}
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("Break glass credentials are only supported on Hosted Control Plane clusters")
+		})
+
+		It("fails if external authentication is not enabled", func() {
+			cluster, err := cmv1.NewCluster().
+				ID("123").
+				Name("cluster").
+				Hypershift(cmv1.NewHypershift().Enabled(true)).
+				ExternalAuthConfig(cmv1.NewExternalAuthConfig().Enabled(false)).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithOcmObjectMarshal(http.StatusOK, cluster, cmv1.MarshalCluster),
+				),
+			)
+
+			Terraform.Source(`
+				ephemeral "rhcs_break_glass_credential" "break_glass" {
+					cluster = "123"
+				}
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("External Authentication Configuration is not enabled")
+		})
+
+		It("fails if expiration duration exceeds 24 hours", func() {
+			Terraform.Source(`
+				ephemeral "rhcs_break_glass_credential" "break_glass" {
+					cluster             = "123"
+					expiration_duration = "25h"
+				}
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("The expiration duration needs to be at maximum 24 hours")
+		})
+	})
+})
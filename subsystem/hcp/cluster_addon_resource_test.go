@@ -0,0 +1,374 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package hcp
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Cluster add-on", func() {
+	const installationRoute = cluster123Route + "/addons/my-addon"
+
+	clusterReady := `{
+	  "kind": "Cluster",
+	  "id": "123",
+	  "href": "/api/clusters_mgmt/v1/clusters/123",
+	  "name": "my-cluster",
+	  "state": "ready"
+	}`
+
+	addOn := `{
+	  "kind": "AddOn",
+	  "id": "my-addon",
+	  "name": "My add-on",
+	  "enabled": true,
+	  "version": {
+	    "id": "1.0.0"
+	  },
+	  "parameters": {
+	    "items": [
+	      {
+	        "id": "size",
+	        "name": "Size",
+	        "value_type": "string",
+	        "required": true,
+	        "editable": true,
+	        "enabled": true,
+	        "options": [
+	          {"name": "Small", "value": "small"},
+	          {"name": "Large", "value": "large"}
+	        ]
+	      },
+	      {
+	        "id": "replicas",
+	        "name": "Replicas",
+	        "value_type": "number",
+	        "editable": true,
+	        "enabled": true,
+	        "default_value": "1"
+	      },
+	      {
+	        "id": "storage-class",
+	        "name": "Storage class",
+	        "value_type": "string",
+	        "editable": false,
+	        "enabled": true,
+	        "default_value": "gp3"
+	      }
+	    ]
+	  }
+	}`
+
+	installation := func(state, size string) string {
+		return `{
+		  "kind": "AddOnInstallation",
+		  "id": "my-addon",
+		  "href": "` + installationRoute + `",
+		  "addon": {
+		    "kind": "AddOnLink",
+		    "id": "my-addon"
+		  },
+		  "addon_version": {
+		    "id": "1.0.0"
+		  },
+		  "state": "` + state + `",
+		  "parameters": {
+		    "items": [
+		      {"id": "size", "value": "` + size + `"},
+		      {"id": "replicas", "value": "1"},
+		      {"id": "storage-class", "value": "gp3"}
+		    ]
+		  }
+		}`
+	}
+
+	BeforeEach(func() {
+		// The add-on is fetched each time the parameters are validated:
+		TestServer.RouteToHandler(http.MethodGet, "/api/clusters_mgmt/v1/addons/my-addon",
+			RespondWithJSON(http.StatusOK, addOn))
+	})
+
+	It("Installs, updates and removes the add-on", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, cluster123Route),
+				RespondWithJSON(http.StatusOK, clusterReady),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, cluster123Route+"/addons"),
+				VerifyJQ(".addon.id", "my-addon"),
+				VerifyJQ(".parameters.items[0].id", "size"),
+				VerifyJQ(".parameters.items[0].value", "small"),
+				RespondWithJSON(http.StatusCreated, installation("installing", "small")),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, installationRoute),
+				RespondWithJSON(http.StatusOK, installation("ready", "small")),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster_addon" "my_addon" {
+		    cluster    = "123"
+		    addon      = "my-addon"
+		    parameters = {
+		      size = "small"
+		    }
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state. Only the parameters given in the configuration are kept:
+		resource := Terraform.Resource("rhcs_cluster_addon", "my_addon")
+		Expect(resource).To(MatchJQ(`.attributes.id`, "my-addon"))
+		Expect(resource).To(MatchJQ(`.attributes.version`, "1.0.0"))
+		Expect(resource).To(MatchJQ(`.attributes.state`, "ready"))
+		Expect(resource).To(MatchJQ(`.attributes.parameters | length`, 1))
+		Expect(resource).To(MatchJQ(`.attributes.parameters.size`, "small"))
+
+		// Change the size:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, installationRoute),
+				RespondWithJSON(http.StatusOK, installation("ready", "small")),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodPatch, installationRoute),
+				VerifyJQ(".parameters.items[0].value", "large"),
+				RespondWithJSON(http.StatusOK, installation("installing", "large")),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, installationRoute),
+				RespondWithJSON(http.StatusOK, installation("ready", "large")),
+			),
+		)
+		Terraform.Source(`
+		  resource "rhcs_cluster_addon" "my_addon" {
+		    cluster    = "123"
+		    addon      = "my-addon"
+		    parameters = {
+		      size = "large"
+		    }
+		  }
+		`)
+		runOutput = Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+		resource = Terraform.Resource("rhcs_cluster_addon", "my_addon")
+		Expect(resource).To(MatchJQ(`.attributes.parameters.size`, "large"))
+
+		// Remove the add-on:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, installationRoute),
+				RespondWithJSON(http.StatusOK, installation("ready", "large")),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodDelete, installationRoute),
+				RespondWithJSON(http.StatusNoContent, "{}"),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, installationRoute),
+				RespondWithJSON(http.StatusNotFound, "{}"),
+			),
+		)
+		runOutput = Terraform.Destroy()
+		Expect(runOutput.ExitCode).To(BeZero())
+	})
+
+	It("Fails when the installation fails", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, cluster123Route),
+				RespondWithJSON(http.StatusOK, clusterReady),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, cluster123Route+"/addons"),
+				RespondWithJSON(http.StatusCreated, installation("installing", "small")),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, installationRoute),
+				RespondWithJSON(http.StatusOK, installation("failed", "small")),
+			),
+		)
+		Terraform.Source(`
+		  resource "rhcs_cluster_addon" "my_addon" {
+		    cluster    = "123"
+		    addon      = "my-addon"
+		    parameters = {
+		      size = "small"
+		    }
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("add-on 'my-addon' of cluster '123' failed")
+	})
+
+	It("Rejects unsupported parameters when planning", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster_addon" "my_addon" {
+		    cluster    = "123"
+		    addon      = "my-addon"
+		    parameters = {
+		      size  = "small"
+		      color = "blue"
+		    }
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("parameter 'color' isn't supported by add-on 'my-addon'")
+	})
+
+	It("Rejects values that aren't valid options when planning", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster_addon" "my_addon" {
+		    cluster    = "123"
+		    addon      = "my-addon"
+		    parameters = {
+		      size     = "huge"
+		      replicas = "two"
+		    }
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("must be one of 'small', 'large'")
+		runOutput.VerifyErrorContainsSubstring("must be a number")
+	})
+
+	It("Rejects missing required parameters when planning", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster_addon" "my_addon" {
+		    cluster = "123"
+		    addon   = "my-addon"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("parameter 'size' is required by add-on 'my-addon'")
+	})
+
+	It("Can import an add-on and rejects changes to parameters that aren't editable", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, installationRoute),
+				RespondWithJSON(http.StatusOK, installation("ready", "small")),
+			),
+		)
+		Terraform.Source(`
+		  resource "rhcs_cluster_addon" "my_addon" {
+		    cluster    = "123"
+		    addon      = "my-addon"
+		    parameters = {
+		      size            = "small"
+		      replicas        = "1"
+		      "storage-class" = "gp2"
+		    }
+		  }
+		`)
+		runOutput := Terraform.Import("rhcs_cluster_addon.my_addon", "123,my-addon")
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// All the parameters of the installation are imported:
+		resource := Terraform.Resource("rhcs_cluster_addon", "my_addon")
+		Expect(resource).To(MatchJQ(`.attributes.addon`, "my-addon"))
+		Expect(resource).To(MatchJQ(`.attributes.parameters | length`, 3))
+		Expect(resource).To(MatchJQ(`.attributes.parameters["storage-class"]`, "gp3"))
+
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, installationRoute),
+				RespondWithJSON(http.StatusOK, installation("ready", "small")),
+			),
+		)
+		runOutput = Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("parameter 'storage-class' can't be changed after the add-on is installed")
+	})
+})
+
+var _ = Describe("Add-ons data source", func() {
+	It("Lists the add-ons with their parameters", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/addons"),
+				VerifyFormKV("search", "id like 'my-%'"),
+				RespondWithJSON(http.StatusOK, `{
+				  "kind": "AddOnList",
+				  "page": 1,
+				  "size": 2,
+				  "total": 2,
+				  "items": [
+				    {
+				      "kind": "AddOn",
+				      "id": "my-addon",
+				      "name": "My add-on",
+				      "enabled": true,
+				      "docs_link": "https://example.com/my-addon",
+				      "version": {
+				        "id": "1.0.0"
+				      },
+				      "parameters": {
+				        "items": [
+				          {
+				            "id": "size",
+				            "name": "Size",
+				            "value_type": "string",
+				            "required": true,
+				            "editable": true,
+				            "enabled": true,
+				            "options": [
+				              {"name": "Small", "value": "small"}
+				            ]
+				          },
+				          {
+				            "id": "legacy",
+				            "name": "Legacy",
+				            "value_type": "string",
+				            "enabled": false
+				          }
+				        ]
+				      }
+				    },
+				    {
+				      "kind": "AddOn",
+				      "id": "my-disabled-addon",
+				      "name": "My disabled add-on",
+				      "enabled": false
+				    }
+				  ]
+				}`),
+			),
+		)
+
+		Terraform.Source(`
+		  data "rhcs_addons" "mine" {
+		    search = "id like 'my-%'"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Only the enabled add-ons and parameters are listed:
+		dataSource := Terraform.Resource("rhcs_addons", "mine")
+		Expect(dataSource).To(MatchJQ(`.attributes.items | length`, 1))
+		Expect(dataSource).To(MatchJQ(`.attributes.items[0].id`, "my-addon"))
+		Expect(dataSource).To(MatchJQ(`.attributes.items[0].version`, "1.0.0"))
+		Expect(dataSource).To(MatchJQ(`.attributes.items[0].docs_link`, "https://example.com/my-addon"))
+		Expect(dataSource).To(MatchJQ(`.attributes.items[0].parameters | length`, 1))
+		Expect(dataSource).To(MatchJQ(`.attributes.items[0].parameters[0].required`, true))
+		Expect(dataSource).To(MatchJQ(`.attributes.items[0].parameters[0].options[0].value`, "small"))
+	})
+})
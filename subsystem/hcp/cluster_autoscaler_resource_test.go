@@ -204,6 +204,7 @@ var _ = Describe("Hcp Cluster Autoscaler", func() {
 					"pod_priority_threshold":  nil,
 					"max_node_provision_time": "1h",
 					"resource_limits":         nil,
+					"timeouts":                nil,
 				},
 			))
 		})
@@ -268,6 +269,7 @@ var _ = Describe("Hcp Cluster Autoscaler", func() {
 					"pod_priority_threshold":  nil,
 					"max_node_provision_time": "2h",
 					"resource_limits":         nil,
+					"timeouts":                nil,
 				},
 			))
 		})
@@ -0,0 +1,95 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package hcp
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Cluster limited support reasons data source", func() {
+	It("Lists the active reasons", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, cluster123Route+"/limited_support_reasons"),
+				RespondWithJSON(http.StatusOK, `{
+				  "kind": "LimitedSupportReasonList",
+				  "page": 1,
+				  "size": 2,
+				  "total": 2,
+				  "items": [
+				    {
+				      "kind": "LimitedSupportReason",
+				      "id": "456",
+				      "summary": "Cluster is missing the required egress",
+				      "details": "Restore the egress to the required domains",
+				      "detection_type": "auto",
+				      "creation_timestamp": "2026-10-01T10:00:00Z"
+				    },
+				    {
+				      "kind": "LimitedSupportReason",
+				      "id": "789",
+				      "summary": "Cluster was upgraded manually",
+				      "details": "Upgrades must be done with OCM",
+				      "detection_type": "manual",
+				      "override": {
+				        "enabled": true
+				      }
+				    }
+				  ]
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_cluster_limited_support_reasons" "reasons" {
+		    cluster = "123"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state. Overridden reasons aren't included:
+		dataSource := Terraform.Resource("rhcs_cluster_limited_support_reasons", "reasons")
+		Expect(dataSource).To(MatchJQ(`.attributes.items | length`, 1))
+		Expect(dataSource).To(MatchJQ(`.attributes.items[0].id`, "456"))
+		Expect(dataSource).To(MatchJQ(`.attributes.items[0].summary`, "Cluster is missing the required egress"))
+		Expect(dataSource).To(MatchJQ(`.attributes.items[0].details`, "Restore the egress to the required domains"))
+		Expect(dataSource).To(MatchJQ(`.attributes.items[0].detection_type`, "auto"))
+		Expect(dataSource).To(MatchJQ(`.attributes.items[0].creation_timestamp`, "2026-10-01T10:00:00Z"))
+	})
+
+	It("Returns an empty list for clusters with full support", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, cluster123Route+"/limited_support_reasons"),
+				RespondWithJSON(http.StatusOK, `{
+				  "kind": "LimitedSupportReasonList",
+				  "page": 1,
+				  "size": 0,
+				  "total": 0,
+				  "items": []
+				}`),
+			),
+		)
+
+		Terraform.Source(`
+		  data "rhcs_cluster_limited_support_reasons" "reasons" {
+		    cluster = "123"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+		dataSource := Terraform.Resource("rhcs_cluster_limited_support_reasons", "reasons")
+		Expect(dataSource).To(MatchJQ(`.attributes.items | length`, 0))
+	})
+})
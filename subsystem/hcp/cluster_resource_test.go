@@ -1045,6 +1045,14 @@ var _ = Describe("HCP Cluster", func() {
 						"value": "error"
 						}]`),
 					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/inflight_checks"),
+						RespondWithJSON(http.StatusOK, `{"kind": "InflightCheckList", "items": []}`),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/logs/install"),
+						RespondWithJSON(http.StatusOK, `{"kind": "Log", "id": "install", "content": ""}`),
+					),
 				)
 
 				// Run the apply command:
@@ -3403,6 +3411,193 @@ var _ = Describe("HCP Cluster", func() {
 			Expect(resource).To(MatchJQ(".attributes.admin_credentials.password", "1234AbB2341234"))
 		})
 
+		It("Fails to create hcp cluster with both admin password and write-only admin password", func() {
+			// Run the apply command:
+			Terraform.Source(`
+			resource "rhcs_cluster_rosa_hcp" "my_cluster" {
+				name           = "my-cluster"
+				cloud_region   = "us-west-1"
+				aws_account_id = "123456789012"
+				aws_billing_account_id = "123456789012"
+				admin_credentials = {
+					username = "test-admin"
+					password = "1234AbB2341234"
+				}
+				admin_password_wo = "1234AbB2341234"
+				sts = {
+					operator_role_prefix = "test"
+					role_arn = "",
+					support_role_arn = "",
+					instance_iam_roles = {
+						master_role_arn = "",
+						worker_role_arn = "",
+					}
+				}
+				aws_subnet_ids = [
+					"id1", "id2", "id3"
+				]
+				availability_zones = [
+					"us-west-1a",
+					"us-west-1b",
+					"us-west-1c",
+				]
+			}`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("Invalid Attribute Combination")
+		})
+
+		It("Removes the admin password from the state when moving to the write-only admin password", func() {
+			// Prepare the server:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+					RespondWithJSON(http.StatusOK, versionListPage),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+					VerifyJQ(`.htpasswd.users.items[0].username`, "test-admin"),
+					VerifyJQ(`.htpasswd.users.items[0].hashed_password`, "hash(1234AbB2341234)"),
+					RespondWithPatchedJSON(http.StatusCreated, template, `[
+					{
+					  "op": "add",
+					  "path": "/aws",
+					  "value": {
+						  "ec2_metadata_http_tokens": "optional",
+						  "sts" : {
+							  "oidc_endpoint_url": "https://127.0.0.1",
+							  "thumbprint": "111111",
+							  "role_arn": "",
+							  "support_role_arn": "",
+							  "instance_iam_roles" : {
+								"master_role_arn" : "",
+								"worker_role_arn" : ""
+							  },
+							  "operator_role_prefix" : "test"
+						  }
+					  }
+					}]`),
+				),
+			)
+
+			// Create the cluster with the password in the state:
+			Terraform.Source(`
+			resource "rhcs_cluster_rosa_hcp" "my_cluster" {
+				name           = "my-cluster"
+				cloud_region   = "us-west-1"
+				aws_account_id = "123456789012"
+				aws_billing_account_id = "123456789012"
+				admin_credentials = {
+					username = "test-admin"
+					password = "1234AbB2341234"
+				}
+				sts = {
+					operator_role_prefix = "test"
+					role_arn = "",
+					support_role_arn = "",
+					instance_iam_roles = {
+						master_role_arn = "",
+						worker_role_arn = "",
+					}
+				}
+				aws_subnet_ids = [
+					"id1", "id2", "id3"
+				]
+				availability_zones = [
+					"us-west-1a",
+					"us-west-1b",
+					"us-west-1c",
+				]
+			}`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			resource := Terraform.Resource("rhcs_cluster_rosa_hcp", "my_cluster")
+			Expect(resource).To(MatchJQ(".attributes.admin_credentials.password", "1234AbB2341234"))
+
+			// Prepare the server for the update:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route),
+					RespondWithPatchedJSON(http.StatusOK, template, `[
+					{
+					  "op": "add",
+					  "path": "/aws",
+					  "value": {
+						  "ec2_metadata_http_tokens": "optional",
+						  "sts" : {
+							  "oidc_endpoint_url": "https://127.0.0.1",
+							  "thumbprint": "111111",
+							  "role_arn": "",
+							  "support_role_arn": "",
+							  "instance_iam_roles" : {
+								"master_role_arn" : "",
+								"worker_role_arn" : ""
+							  },
+							  "operator_role_prefix" : "test"
+						  }
+					  }
+					}]`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, cluster123Route),
+					RespondWithPatchedJSON(http.StatusOK, template, `[
+					{
+					  "op": "add",
+					  "path": "/aws",
+					  "value": {
+						  "ec2_metadata_http_tokens": "optional",
+						  "sts" : {
+							  "oidc_endpoint_url": "https://127.0.0.1",
+							  "thumbprint": "111111",
+							  "role_arn": "",
+							  "support_role_arn": "",
+							  "instance_iam_roles" : {
+								"master_role_arn" : "",
+								"worker_role_arn" : ""
+							  },
+							  "operator_role_prefix" : "test"
+						  }
+					  }
+					}]`),
+				),
+			)
+
+			// Move to the write-only password:
+			Terraform.Source(`
+			resource "rhcs_cluster_rosa_hcp" "my_cluster" {
+				name           = "my-cluster"
+				cloud_region   = "us-west-1"
+				aws_account_id = "123456789012"
+				aws_billing_account_id = "123456789012"
+				admin_credentials = {
+					username = "test-admin"
+				}
+				admin_password_wo = "1234AbB2341234"
+				sts = {
+					operator_role_prefix = "test"
+					role_arn = "",
+					support_role_arn = "",
+					instance_iam_roles = {
+						master_role_arn = "",
+						worker_role_arn = "",
+					}
+				}
+				aws_subnet_ids = [
+					"id1", "id2", "id3"
+				]
+				availability_zones = [
+					"us-west-1a",
+					"us-west-1b",
+					"us-west-1c",
+				]
+			}`)
+			runOutput = Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			resource = Terraform.Resource("rhcs_cluster_rosa_hcp", "my_cluster")
+			Expect(resource).To(MatchJQ(".attributes.admin_credentials.username", "test-admin"))
+			Expect(resource).To(MatchJQ(".attributes.admin_credentials.password", nil))
+		})
+
 		It("Creates basic cluster with blocked registries and update them", func() {
 			// Prepare the server:
 			TestServer.AppendHandlers(
@@ -7003,6 +7198,13 @@ var _ = Describe("HCP Cluster", func() {
 				)
 			})
 			It("Fails upgrade for un-acked gates", func() {
+				// No gate was acknowledged beforehand
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/gate_agreements"),
+						RespondWithJSON(http.StatusOK, `{"page": 1, "size": 0, "total": 0, "items": []}`),
+					),
+				)
 				Terraform.Source(`
 				resource "rhcs_cluster_rosa_hcp" "my_cluster" {
 					name           = "my-cluster"
@@ -7030,6 +7232,13 @@ var _ = Describe("HCP Cluster", func() {
 				Expect(Terraform.Apply()).NotTo(BeZero())
 			})
 			It("Fails upgrade if wrong version is acked", func() {
+				// No gate was acknowledged beforehand
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/gate_agreements"),
+						RespondWithJSON(http.StatusOK, `{"page": 1, "size": 0, "total": 0, "items": []}`),
+					),
+				)
 				Terraform.Source(`
 				resource "rhcs_cluster_rosa_hcp" "my_cluster" {
 					name           = "my-cluster"
@@ -7132,6 +7341,75 @@ var _ = Describe("HCP Cluster", func() {
 				runOutput := Terraform.Apply()
 				Expect(runOutput.ExitCode).To(BeZero())
 			})
+			It("Doesn't require the ack for gates that are already agreed", func() {
+				TestServer.AppendHandlers(
+					// The gate was acknowledged beforehand
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/gate_agreements"),
+						RespondWithJSON(http.StatusOK, `{
+						"page": 1,
+						"size": 1,
+						"total": 1,
+						"items": [
+							{
+								"kind": "VersionGateAgreement",
+								"id": "888",
+								"version_gate": {
+									"kind": "VersionGate",
+									"id": "999"
+								}
+							}
+						]
+						}`),
+					),
+					// Create an upgrade policy without acking the gate again
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, cluster123Route+"/control_plane/upgrade_policies"),
+						VerifyJQ(".version", "4.14.1"),
+						RespondWithJSON(http.StatusCreated, `
+						{
+							"kind": "UpgradePolicy",
+							"id": "123",
+							"schedule_type": "manual",
+							"upgrade_type": "ControlPlane",
+							"version": "4.14.1",
+							"next_run": "2023-06-09T20:59:00Z",
+							"cluster_id": "123"
+						}`),
+					),
+					// Patch the cluster (w/ no changes)
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, cluster123Route),
+						RespondWithJSON(http.StatusCreated, template),
+					),
+				)
+				Terraform.Source(`
+				resource "rhcs_cluster_rosa_hcp" "my_cluster" {
+					name           = "my-cluster"
+					cloud_region   = "us-west-1"
+					aws_account_id = "123456789012"
+					aws_billing_account_id = "123456789012"
+					sts = {
+						operator_role_prefix = "test"
+						role_arn = ""
+						support_role_arn = ""
+						instance_iam_roles = {
+							worker_role_arn = ""
+						}
+					}
+					aws_subnet_ids = [
+						"id1", "id2", "id3"
+					]
+					availability_zones = [
+						"us-west-1a",
+						"us-west-1b",
+						"us-west-1c",
+					]
+					version = "4.14.1"
+				}`)
+				runOutput := Terraform.Apply()
+				Expect(runOutput.ExitCode).To(BeZero())
+			})
 		})
 	})
 
@@ -7184,6 +7462,66 @@ var _ = Describe("HCP Cluster", func() {
 			resource := Terraform.Resource("rhcs_cluster_rosa_hcp", "my_cluster")
 			Expect(resource).To(MatchJQ(".attributes.current_version", "4.14.0"))
 		})
+
+		It("warns when the cluster is in limited support", func() {
+			// Prepare the server:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route),
+					RespondWithPatchedJSON(http.StatusOK, template, `[
+						{
+						  "op": "add",
+						  "path": "/aws",
+						  "value": {
+							  "sts" : {
+								  "oidc_endpoint_url": "https://127.0.0.1",
+								  "thumbprint": "111111",
+								  "role_arn": "",
+								  "support_role_arn": "",
+								  "instance_iam_roles" : {
+									"master_role_arn" : "",
+									"worker_role_arn" : ""
+								  },
+								  "operator_role_prefix" : "test"
+							  }
+						  }
+						},
+						{
+						  "op": "add",
+						  "path": "/status",
+						  "value": {
+							"state": "ready",
+							"limited_support_reason_count": 1
+						  }
+						}]`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/limited_support_reasons"),
+					RespondWithJSON(http.StatusOK, `{
+					  "kind": "LimitedSupportReasonList",
+					  "page": 1,
+					  "size": 1,
+					  "total": 1,
+					  "items": [
+					    {
+					      "kind": "LimitedSupportReason",
+					      "id": "456",
+					      "summary": "Cluster is missing the required egress",
+					      "details": "Restore the egress to the required domains",
+					      "detection_type": "auto"
+					    }
+					  ]
+					}`),
+				),
+			)
+
+			// Run the import command:
+			Terraform.Source(`resource "rhcs_cluster_rosa_hcp" "my_cluster" {}`)
+			runOutput := Terraform.Import("rhcs_cluster_rosa_hcp.my_cluster", "123")
+			Expect(runOutput.ExitCode).To(BeZero())
+			runOutput.VerifyOutputContainsSubstring("Cluster '123' is in limited support")
+			runOutput.VerifyOutputContainsSubstring("Cluster is missing the required egress")
+		})
 	})
 
 	Context("External Authentication", func() {
@@ -0,0 +1,128 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package hcp
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Cluster service logs data source", func() {
+	const serviceLogsRoute = "/api/service_logs/v1/clusters/cluster_logs"
+
+	It("Lists all the entries of the cluster", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, serviceLogsRoute),
+				VerifyFormKV("cluster_id", "123"),
+				VerifyFormKV("order", "timestamp desc"),
+				RespondWithJSON(http.StatusOK, `{
+				  "kind": "ClusterLogList",
+				  "page": 1,
+				  "size": 2,
+				  "total": 2,
+				  "items": [
+				    {
+				      "kind": "ClusterLog",
+				      "id": "456",
+				      "cluster_id": "123",
+				      "timestamp": "2026-10-02T10:00:00Z",
+				      "severity": "Error",
+				      "service_name": "SREManualAction",
+				      "log_type": "cluster-state-updates",
+				      "summary": "Action required: restore egress",
+				      "description": "The cluster can't reach the required domains.",
+				      "doc_references": ["https://docs.example.com/egress"]
+				    },
+				    {
+				      "kind": "ClusterLog",
+				      "id": "457",
+				      "cluster_id": "123",
+				      "timestamp": "2026-10-01T10:00:00Z",
+				      "severity": "Info",
+				      "service_name": "LimitedSupport",
+				      "summary": "Cluster upgraded"
+				    }
+				  ]
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_cluster_service_logs" "logs" {
+		    cluster = "123"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		dataSource := Terraform.Resource("rhcs_cluster_service_logs", "logs")
+		Expect(dataSource).To(MatchJQ(`.attributes.items | length`, 2))
+		Expect(dataSource).To(MatchJQ(`.attributes.items[0].id`, "456"))
+		Expect(dataSource).To(MatchJQ(`.attributes.items[0].timestamp`, "2026-10-02T10:00:00Z"))
+		Expect(dataSource).To(MatchJQ(`.attributes.items[0].severity`, "Error"))
+		Expect(dataSource).To(MatchJQ(`.attributes.items[0].service_name`, "SREManualAction"))
+		Expect(dataSource).To(MatchJQ(`.attributes.items[0].log_type`, "cluster-state-updates"))
+		Expect(dataSource).To(MatchJQ(`.attributes.items[0].summary`, "Action required: restore egress"))
+		Expect(dataSource).To(MatchJQ(`.attributes.items[0].doc_references[0]`, "https://docs.example.com/egress"))
+		Expect(dataSource).To(MatchJQ(`.attributes.items[1].doc_references | length`, 0))
+	})
+
+	It("Sends the filters in the search expression", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, serviceLogsRoute),
+				VerifyFormKV("cluster_id", "123"),
+				VerifyFormKV("search", "severity in ('Error', 'Critical') and "+
+					"service_name = 'SREManualAction' and "+
+					"timestamp >= '2026-10-01T00:00:00Z' and "+
+					"timestamp < '2026-10-08T00:00:00Z'"),
+				RespondWithJSON(http.StatusOK, `{
+				  "kind": "ClusterLogList",
+				  "page": 1,
+				  "size": 0,
+				  "total": 0,
+				  "items": []
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_cluster_service_logs" "logs" {
+		    cluster      = "123"
+		    severities   = ["Error", "Critical"]
+		    service_name = "SREManualAction"
+		    start_time   = "2026-10-01T00:00:00Z"
+		    end_time     = "2026-10-08T00:00:00Z"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+		dataSource := Terraform.Resource("rhcs_cluster_service_logs", "logs")
+		Expect(dataSource).To(MatchJQ(`.attributes.items | length`, 0))
+	})
+
+	It("Rejects unknown severities", func() {
+		Terraform.Source(`
+		  data "rhcs_cluster_service_logs" "logs" {
+		    cluster    = "123"
+		    severities = ["Bad"]
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("Invalid Attribute Value Match")
+	})
+})
@@ -0,0 +1,244 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hcp
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"                      // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("HCP Cluster Upgrade Policy", func() {
+	const upgradePoliciesRoute = "/api/clusters_mgmt/v1/clusters/123/control_plane/upgrade_policies"
+
+	clusterReady := `{
+		"kind": "Cluster",
+		"id": "123",
+		"href": "/api/clusters_mgmt/v1/clusters/123",
+		"name": "test-cluster",
+		"state": "ready",
+		"hypershift": {
+			"enabled": true
+		}
+	}`
+
+	emptyUpgradePolicies := `{
+		"page": 1,
+		"size": 0,
+		"total": 0,
+		"items": []
+	}`
+
+	recurringPolicy := `{
+		"kind": "ControlPlaneUpgradePolicy",
+		"id": "policy-1",
+		"cluster_id": "123",
+		"schedule_type": "automatic",
+		"upgrade_type": "ControlPlane",
+		"schedule": "0 2 * * 0",
+		"enable_minor_version_upgrades": false,
+		"next_run": "2026-10-25T02:00:00Z",
+		"state": {
+			"value": "scheduled"
+		}
+	}`
+
+	recurringPolicies := `{
+		"page": 1,
+		"size": 1,
+		"total": 1,
+		"items": [` + recurringPolicy + `]
+	}`
+
+	Context("validation", func() {
+		It("fails if the schedule is not a valid cron expression", func() {
+			Terraform.Source(`
+				resource "rhcs_hcp_cluster_upgrade_policy" "policy" {
+					cluster  = "123"
+					schedule = "every sunday"
+				}
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("Invalid cron expression")
+		})
+	})
+
+	Context("creation", func() {
+		It("fails if the cluster already has a recurring policy", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithJSON(http.StatusOK, clusterReady),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, upgradePoliciesRoute),
+					RespondWithJSON(http.StatusOK, recurringPolicies),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, upgradePoliciesRoute+"/policy-1"),
+					RespondWithJSON(http.StatusOK, recurringPolicy),
+				),
+			)
+
+			Terraform.Source(`
+				resource "rhcs_hcp_cluster_upgrade_policy" "policy" {
+					cluster  = "123"
+					schedule = "0 2 * * 0"
+				}
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("already has the recurring upgrade policy 'policy-1'")
+		})
+
+		It("creates a recurring policy", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithJSON(http.StatusOK, clusterReady),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, upgradePoliciesRoute),
+					RespondWithJSON(http.StatusOK, emptyUpgradePolicies),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, upgradePoliciesRoute),
+					VerifyJQ(".schedule_type", "automatic"),
+					VerifyJQ(".schedule", "0 2 * * 0"),
+					VerifyJQ(".enable_minor_version_upgrades", false),
+					RespondWithJSON(http.StatusCreated, recurringPolicy),
+				),
+			)
+
+			Terraform.Source(`
+				resource "rhcs_hcp_cluster_upgrade_policy" "policy" {
+					cluster  = "123"
+					schedule = "0 2 * * 0"
+				}
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			resource := Terraform.Resource("rhcs_hcp_cluster_upgrade_policy", "policy")
+			Expect(resource).To(MatchJQ(".attributes.id", "policy-1"))
+			Expect(resource).To(MatchJQ(".attributes.next_run", "2026-10-25T02:00:00Z"))
+			Expect(resource).To(MatchJQ(".attributes.state", "scheduled"))
+		})
+	})
+
+	Context("import, update and deletion", func() {
+		BeforeEach(func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, upgradePoliciesRoute),
+					RespondWithJSON(http.StatusOK, recurringPolicies),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, upgradePoliciesRoute+"/policy-1"),
+					RespondWithJSON(http.StatusOK, recurringPolicy),
+				),
+			)
+
+			Terraform.Source(`
+				resource "rhcs_hcp_cluster_upgrade_policy" "policy" {
+					cluster  = "123"
+					schedule = "0 2 * * 0"
+				}
+			`)
+			runOutput := Terraform.Import("rhcs_hcp_cluster_upgrade_policy.policy", "123")
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			resource := Terraform.Resource("rhcs_hcp_cluster_upgrade_policy", "policy")
+			Expect(resource).To(MatchJQ(".attributes.id", "policy-1"))
+			Expect(resource).To(MatchJQ(".attributes.schedule", "0 2 * * 0"))
+		})
+
+		It("updates the schedule and enables minor version upgrades", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, upgradePoliciesRoute),
+					RespondWithJSON(http.StatusOK, recurringPolicies),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, upgradePoliciesRoute+"/policy-1"),
+					RespondWithJSON(http.StatusOK, recurringPolicy),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, upgradePoliciesRoute+"/policy-1"),
+					VerifyJQ(".schedule", "30 4 * * 6"),
+					VerifyJQ(".enable_minor_version_upgrades", true),
+					RespondWithPatchedJSON(http.StatusOK, recurringPolicy, `[
+						{ "op": "replace", "path": "/schedule", "value": "30 4 * * 6" },
+						{ "op": "replace", "path": "/enable_minor_version_upgrades", "value": true }
+					]`),
+				),
+			)
+
+			Terraform.Source(`
+				resource "rhcs_hcp_cluster_upgrade_policy" "policy" {
+					cluster                       = "123"
+					schedule                      = "30 4 * * 6"
+					enable_minor_version_upgrades = true
+				}
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			resource := Terraform.Resource("rhcs_hcp_cluster_upgrade_policy", "policy")
+			Expect(resource).To(MatchJQ(".attributes.schedule", "30 4 * * 6"))
+			Expect(resource).To(MatchJQ(".attributes.enable_minor_version_upgrades", true))
+		})
+
+		It("deletes the policy", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, upgradePoliciesRoute),
+					RespondWithJSON(http.StatusOK, recurringPolicies),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, upgradePoliciesRoute+"/policy-1"),
+					RespondWithJSON(http.StatusOK, recurringPolicy),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, upgradePoliciesRoute+"/policy-1"),
+					RespondWithJSON(http.StatusNoContent, "{}"),
+				),
+			)
+
+			runOutput := Terraform.Destroy()
+			Expect(runOutput.ExitCode).To(BeZero())
+		})
+
+		It("removes the policy from the state when it was deleted outside Terraform", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, upgradePoliciesRoute),
+					RespondWithJSON(http.StatusOK, emptyUpgradePolicies),
+				),
+			)
+
+			runOutput := Terraform.Destroy()
+			Expect(runOutput.ExitCode).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,154 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package hcp
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Cluster upgrades data source", func() {
+	cluster := `{
+	  "id": "123",
+	  "name": "my-cluster",
+	  "state": "ready",
+	  "hypershift": {"enabled": true},
+	  "version": {
+	    "id": "openshift-v4.14.0",
+	    "raw_id": "4.14.0",
+	    "channel_group": "stable",
+	    "available_upgrades": ["4.14.1"]
+	  }
+	}`
+
+	It("Can get the available upgrades and the missing gates", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, cluster123Route),
+				RespondWithJSON(http.StatusOK, cluster),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, cluster123Route),
+				RespondWithJSON(http.StatusOK, cluster),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.14.1"),
+				RespondWithJSON(http.StatusOK, `{
+				  "id": "openshift-v4.14.1",
+				  "raw_id": "4.14.1",
+				  "hosted_control_plane_enabled": true
+				}`),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, cluster123Route+"/control_plane/upgrade_policies", "dryRun=true"),
+				VerifyJQ(".version", "4.14.1"),
+				RespondWithJSON(http.StatusBadRequest, `{
+				  "kind": "Error",
+				  "id": "400",
+				  "code": "CLUSTERS-MGMT-400",
+				  "reason": "There are missing version gate agreements for this cluster. See details.",
+				  "details": [
+				    {
+				      "kind": "VersionGate",
+				      "id": "999",
+				      "label": "api.openshift.com/gate-ocp",
+				      "value": "4.14",
+				      "warning_message": "Removed APIs",
+				      "description": "Some APIs have been removed in OpenShift 4.14.",
+				      "documentation_url": "https://access.redhat.com/solutions/0000000",
+				      "sts_only": false
+				    }
+				  ]
+				}`),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies"),
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 1,
+				  "size": 1,
+				  "total": 1,
+				  "items": [
+				    {
+				      "id": "456",
+				      "schedule_type": "manual",
+				      "upgrade_type": "ControlPlane",
+				      "version": "4.14.1",
+				      "next_run": "2023-06-09T20:59:00Z",
+				      "cluster_id": "123"
+				    }
+				  ]
+				}`),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies/456"),
+				RespondWithJSON(http.StatusOK, `{
+				  "id": "456",
+				  "schedule_type": "manual",
+				  "upgrade_type": "ControlPlane",
+				  "version": "4.14.1",
+				  "next_run": "2023-06-09T20:59:00Z",
+				  "cluster_id": "123",
+				  "state": {"value": "scheduled"}
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_cluster_upgrades" "upgrades" {
+		    cluster = "123"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster_upgrades", "upgrades")
+		Expect(resource).To(MatchJQ(`.attributes.current_version`, "4.14.0"))
+		Expect(resource).To(MatchJQ(`.attributes.available_upgrades | length`, 1))
+		Expect(resource).To(MatchJQ(`.attributes.available_upgrades[0].version`, "4.14.1"))
+		Expect(resource).To(MatchJQ(`.attributes.available_upgrades[0].upgrade_acknowledgements_for`, "4.14"))
+		Expect(resource).To(MatchJQ(`.attributes.available_upgrades[0].acknowledgement_required`, true))
+		Expect(resource).To(MatchJQ(`.attributes.available_upgrades[0].missing_gates[0].id`, "999"))
+		Expect(resource).To(MatchJQ(`.attributes.available_upgrades[0].missing_gates[0].documentation_url`,
+			"https://access.redhat.com/solutions/0000000"))
+		Expect(resource).To(MatchJQ(`.attributes.scheduled_upgrades | length`, 1))
+		Expect(resource).To(MatchJQ(`.attributes.scheduled_upgrades[0].id`, "456"))
+		Expect(resource).To(MatchJQ(`.attributes.scheduled_upgrades[0].schedule_type`, "manual"))
+		Expect(resource).To(MatchJQ(`.attributes.scheduled_upgrades[0].next_run`, "2023-06-09T20:59:00Z"))
+		Expect(resource).To(MatchJQ(`.attributes.scheduled_upgrades[0].state`, "scheduled"))
+	})
+
+	It("Fails if the machine pool is given for a classic cluster", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, cluster123Route),
+				RespondWithJSON(http.StatusOK, `{
+				  "id": "123",
+				  "name": "my-cluster",
+				  "hypershift": {"enabled": false}
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_cluster_upgrades" "upgrades" {
+		    cluster      = "123"
+		    machine_pool = "workers"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("only supported for hosted control plane clusters")
+	})
+})
@@ -0,0 +1,313 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hcp
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"    // nolint
+	. "github.com/onsi/gomega"       // nolint
+	. "github.com/onsi/gomega/ghttp" // nolint
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("External Auth Provider", func() {
+	const externalAuthPath = "/api/clusters_mgmt/v1/clusters/123/external_auth_config/external_auths"
+
+	buildCluster := func(hcp bool, externalAuth bool) *cmv1.Cluster {
+		cluster, err := cmv1.NewCluster().
+			ID("123").
+			Name("cluster").
+			State(cmv1.ClusterStateReady).
+			Hypershift(cmv1.NewHypershift().Enabled(hcp)).
+			ExternalAuthConfig(cmv1.NewExternalAuthConfig().Enabled(externalAuth)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		return cluster
+	}
+
+	buildExternalAuth := func(audiences ...string) *cmv1.ExternalAuth {
+		externalAuth, err := cmv1.NewExternalAuth().
+			ID("my-provider").
+			Issuer(cmv1.NewTokenIssuer().
+				URL("https://issuer.example.com").
+				Audiences(audiences...)).
+			Claim(cmv1.NewExternalAuthClaim().
+				Mappings(cmv1.NewTokenClaimMappings().
+					UserName(cmv1.NewUsernameClaim().Claim("email")).
+					Groups(cmv1.NewGroupsClaim().Claim("groups")))).
+			Clients(cmv1.NewExternalAuthClientConfig().
+				ID("console-client").
+				Component(cmv1.NewClientComponent().Name("console").Namespace("openshift-console")).
+				Type(cmv1.ExternalAuthClientTypeConfidential)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		return externalAuth
+	}
+
+	source := func(audiences string) string {
+		return `
+			resource "rhcs_external_auth_provider" "provider" {
+				cluster = "123"
+				name    = "my-provider"
+				issuer = {
+					url       = "https://issuer.example.com"
+					audiences = ` + audiences + `
+				}
+				claim = {
+					mappings = {
+						username = {
+							claim = "email"
+						}
+						groups = {
+							claim = "groups"
+						}
+					}
+				}
+				clients = [
+					{
+						id     = "console-client"
+						secret = "my-secret"
+						component = {
+							name      = "console"
+							namespace = "openshift-console"
+						}
+					}
+				]
+			}
+		`
+	}
+
+	Context("creation", func() {
+		It("fails if cluster is not HCP", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithOcmObjectMarshal(http.StatusOK, buildCluster(false, false), cmv1.MarshalCluster),
+				),
+			)
+
+			Terraform.Source(source(`["audience"]`))
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("External authentication providers are only supported on Hosted Control Plane clusters")
+		})
+
+		It("fails if external authentication is not enabled", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithOcmObjectMarshal(http.StatusOK, buildCluster(true, false), cmv1.MarshalCluster),
+				),
+			)
+
+			Terraform.Source(source(`["audience"]`))
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("External Authentication Configuration is not enabled")
+		})
+
+		It("fails if name is not a valid DNS label", func() {
+			Terraform.Source(`
+				resource "rhcs_external_auth_provider" "provider" {
+					cluster = "123"
+					name    = "My_Provider"
+					issuer = {
+						url       = "https://issuer.example.com"
+						audiences = ["audience"]
+					}
+				}
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("must match the regexp")
+		})
+
+		It("fails if issuer URL is not https", func() {
+			Terraform.Source(`
+				resource "rhcs_external_auth_provider" "provider" {
+					cluster = "123"
+					name    = "my-provider"
+					issuer = {
+						url       = "http://issuer.example.com"
+						audiences = ["audience"]
+					}
+				}
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("must be a valid https URL")
+		})
+
+		It("fails if no audience is given", func() {
+			Terraform.Source(`
+				resource "rhcs_external_auth_provider" "provider" {
+					cluster = "123"
+					name    = "my-provider"
+					issuer = {
+						url       = "https://issuer.example.com"
+						audiences = []
+					}
+				}
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("list must contain at least 1 elements")
+		})
+
+		It("successfully creates an external auth provider", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithOcmObjectMarshal(http.StatusOK, buildCluster(true, true), cmv1.MarshalCluster),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, externalAuthPath),
+					VerifyJQ(".id", "my-provider"),
+					VerifyJQ(".issuer.url", "https://issuer.example.com"),
+					VerifyJQ(".issuer.audiences", []any{"audience"}),
+					VerifyJQ(".claim.mappings.username.claim", "email"),
+					VerifyJQ(".clients[0].secret", "my-secret"),
+					RespondWithOcmObjectMarshal(http.StatusCreated, buildExternalAuth("audience"), cmv1.MarshalExternalAuth),
+				),
+			)
+
+			Terraform.Source(source(`["audience"]`))
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			actualResource, ok := Terraform.Resource("rhcs_external_auth_provider", "provider").(map[string]any)
+			Expect(ok).To(BeTrue(), "Type conversion failed for the received resource state")
+
+			attributes := actualResource["attributes"].(map[string]any)
+			Expect(attributes["cluster"]).To(Equal("123"))
+			Expect(attributes["id"]).To(Equal("my-provider"))
+			clients := attributes["clients"].([]any)
+			Expect(clients).To(HaveLen(1))
+			client := clients[0].(map[string]any)
+			Expect(client["secret"]).To(Equal("my-secret"))
+			Expect(client["type"]).To(Equal("confidential"))
+		})
+	})
+
+	Context("update and deletion", func() {
+		BeforeEach(func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithOcmObjectMarshal(http.StatusOK, buildCluster(true, true), cmv1.MarshalCluster),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, externalAuthPath),
+					RespondWithOcmObjectMarshal(http.StatusCreated, buildExternalAuth("audience"), cmv1.MarshalExternalAuth),
+				),
+			)
+
+			Terraform.Source(source(`["audience"]`))
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+		})
+
+		It("updates the audiences in place", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, externalAuthPath+"/my-provider"),
+					RespondWithOcmObjectMarshal(http.StatusOK, buildExternalAuth("audience"), cmv1.MarshalExternalAuth),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, externalAuthPath+"/my-provider"),
+					VerifyJQ(".issuer.audiences", []any{"audience", "other"}),
+					RespondWithOcmObjectMarshal(http.StatusOK, buildExternalAuth("audience", "other"), cmv1.MarshalExternalAuth),
+				),
+			)
+
+			Terraform.Source(source(`["audience", "other"]`))
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			actualResource := Terraform.Resource("rhcs_external_auth_provider", "provider").(map[string]any)
+			issuer := actualResource["attributes"].(map[string]any)["issuer"].(map[string]any)
+			Expect(issuer["audiences"]).To(Equal([]any{"audience", "other"}))
+		})
+
+		It("deletes the external auth provider", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, externalAuthPath+"/my-provider"),
+					RespondWithOcmObjectMarshal(http.StatusOK, buildExternalAuth("audience"), cmv1.MarshalExternalAuth),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, externalAuthPath+"/my-provider"),
+					RespondWithJSON(http.StatusNoContent, "{}"),
+				),
+			)
+
+			runOutput := Terraform.Destroy()
+			Expect(runOutput.ExitCode).To(BeZero())
+		})
+
+		It("removes the resource from the state when it was deleted outside Terraform", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, externalAuthPath+"/my-provider"),
+					RespondWithJSON(http.StatusNotFound, `{
+						"kind": "Error",
+						"id": "404",
+						"href": "/api/clusters_mgmt/v1/errors/404",
+						"code": "CLUSTERS-MGMT-404",
+						"reason": "External auth 'my-provider' not found"
+					}`),
+				),
+			)
+
+			runOutput := Terraform.Destroy()
+			Expect(runOutput.ExitCode).To(BeZero())
+		})
+	})
+
+	Context("importing", func() {
+		It("fails if import identifier is invalid", func() {
+			Terraform.Source(source(`["audience"]`))
+			runOutput := Terraform.Import("rhcs_external_auth_provider.provider", "my-provider")
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("Invalid import identifier")
+		})
+
+		It("successfully imports an external auth provider", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, externalAuthPath+"/my-provider"),
+					RespondWithOcmObjectMarshal(http.StatusOK, buildExternalAuth("audience"), cmv1.MarshalExternalAuth),
+				),
+			)
+
+			Terraform.Source(source(`["audience"]`))
+			runOutput := Terraform.Import("rhcs_external_auth_provider.provider", "123,my-provider")
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			actualResource, ok := Terraform.Resource("rhcs_external_auth_provider", "provider").(map[string]any)
+			Expect(ok).To(BeTrue(), "Type conversion failed for the received resource state")
+
+			attributes := actualResource["attributes"].(map[string]any)
+			Expect(attributes["cluster"]).To(Equal("123"))
+			Expect(attributes["name"]).To(Equal("my-provider"))
+		})
+	})
+})
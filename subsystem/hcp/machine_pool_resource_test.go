@@ -5380,6 +5380,13 @@ var _ = Describe("Hcp Machine pool", func() {
 				)
 			})
 			It("Fails upgrade for un-acked gates", func() {
+				// No gate was acknowledged beforehand
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/gate_agreements"),
+						RespondWithJSON(http.StatusOK, `{"page": 1, "size": 0, "total": 0, "items": []}`),
+					),
+				)
 				Terraform.Source(EvaluateTemplate(`
 				resource "rhcs_hcp_machine_pool" "{{.PoolId}}" {
 					cluster      = "{{.ClusterId}}"
@@ -5400,6 +5407,13 @@ var _ = Describe("Hcp Machine pool", func() {
 				Expect(Terraform.Apply()).NotTo(BeZero())
 			})
 			It("Fails upgrade if wrong version is acked", func() {
+				// No gate was acknowledged beforehand
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/gate_agreements"),
+						RespondWithJSON(http.StatusOK, `{"page": 1, "size": 0, "total": 0, "items": []}`),
+					),
+				)
 				Terraform.Source(EvaluateTemplate(`
 				resource "rhcs_hcp_machine_pool" "{{.PoolId}}" {
 					cluster      = "{{.ClusterId}}"
@@ -0,0 +1,110 @@
+// Copyright Red Hat
+// SPDX-License-Identifier: Apache-2.0
+
+package hcp
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Network verification", func() {
+	const verificationsRoute = "/api/clusters_mgmt/v1/network_verifications"
+
+	verification := `{
+	  "kind": "NetworkVerification",
+	  "total": 2,
+	  "items": [
+	    {"kind": "SubnetNetworkVerification", "id": "subnet-1", "state": "pending"},
+	    {"kind": "SubnetNetworkVerification", "id": "subnet-2", "state": "pending"}
+	  ]
+	}`
+
+	source := `
+	  resource "rhcs_network_verification" "subnets" {
+	    subnet_ids   = ["subnet-1", "subnet-2"]
+	    cloud_region = "us-east-1"
+	    role_arn     = "arn:aws:iam::123456789012:role/installer"
+	    platform     = "aws-hosted-cp"
+	  }
+	`
+
+	It("Verifies the subnets", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, verificationsRoute),
+				VerifyJQ(".cloud_provider_data.subnets", []any{"subnet-1", "subnet-2"}),
+				VerifyJQ(".cloud_provider_data.region.id", "us-east-1"),
+				VerifyJQ(".cloud_provider_data.aws.sts.role_arn", "arn:aws:iam::123456789012:role/installer"),
+				VerifyJQ(".platform", "aws-hosted-cp"),
+				RespondWithJSON(http.StatusCreated, verification),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, verificationsRoute+"/subnet-1"),
+				RespondWithJSON(http.StatusOK, `{"id": "subnet-1", "state": "passed"}`),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, verificationsRoute+"/subnet-2"),
+				RespondWithJSON(http.StatusOK, `{"id": "subnet-2", "state": "passed"}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(source)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_network_verification", "subnets")
+		Expect(resource).To(MatchJQ(`.attributes.id`, "subnet-1,subnet-2"))
+		Expect(resource).To(MatchJQ(`.attributes.results | length`, 2))
+		Expect(resource).To(MatchJQ(`.attributes.results[0].subnet_id`, "subnet-1"))
+		Expect(resource).To(MatchJQ(`.attributes.results[0].state`, "passed"))
+		Expect(resource).To(MatchJQ(`.attributes.results[1].subnet_id`, "subnet-2"))
+		Expect(resource).To(MatchJQ(`.attributes.results[1].state`, "passed"))
+
+		// Removing the resource doesn't send any request:
+		runOutput = Terraform.Destroy()
+		Expect(runOutput.ExitCode).To(BeZero())
+	})
+
+	It("Fails with the checks that didn't pass", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, verificationsRoute),
+				RespondWithJSON(http.StatusCreated, verification),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, verificationsRoute+"/subnet-1"),
+				RespondWithJSON(http.StatusOK, `{"id": "subnet-1", "state": "passed"}`),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, verificationsRoute+"/subnet-2"),
+				RespondWithJSON(http.StatusOK, `{
+				  "id": "subnet-2",
+				  "state": "failed",
+				  "details": [
+				    "egressErrorIds: egress-1",
+				    "egressErrorIds: egress-2"
+				  ]
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(source)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("Network verification failed for subnet 'subnet-2'")
+		runOutput.VerifyErrorContainsSubstring("egress-1")
+		runOutput.VerifyErrorContainsSubstring("egress-2")
+	})
+})
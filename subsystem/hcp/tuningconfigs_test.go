@@ -73,6 +73,32 @@ var _ = Describe("Tuning Configs", func() {
 	Expect(err).ToNot(HaveOccurred())
 	tuningConfigSpecTemplate := b.String()
 
+	tunedSpec := map[string]any{
+		"profile": []any{
+			map[string]any{
+				"name": "my-profile",
+				"data": "[main]\nsummary=Custom profile\n",
+			},
+		},
+		"recommend": []any{
+			map[string]any{
+				"priority": float64(20),
+				"profile":  "my-profile",
+			},
+		},
+	}
+	tuningConfigTunedBuilder := cmv1.NewTuningConfig().
+		ID("456").
+		HREF("/api/clusters_mgmt/v1/clusters/123/tuning_configs/456").
+		Name("my_config").
+		Spec(tunedSpec)
+	tuningConfigTuned, err := tuningConfigTunedBuilder.Build()
+	Expect(err).ToNot(HaveOccurred())
+	b.Reset()
+	err = cmv1.MarshalTuningConfig(tuningConfigTuned, b)
+	Expect(err).ToNot(HaveOccurred())
+	tuningConfigTunedTemplate := b.String()
+
 	Context("tuning configs creation", func() {
 		It("fails if spec is not supplied", func() {
 			Terraform.Source(`
@@ -82,7 +108,80 @@ var _ = Describe("Tuning Configs", func() {
 			`)
 			runOutput := Terraform.Apply()
 			Expect(runOutput.ExitCode).ToNot(BeZero())
-			runOutput.VerifyErrorContainsSubstring(`The argument "spec" is required`)
+			runOutput.VerifyErrorContainsSubstring("No attribute specified when one (and only one) of")
+		})
+		It("fails if both spec and profile are supplied", func() {
+			Terraform.Source(`
+			resource "rhcs_tuning_config" "tuning_config" {
+					cluster = "123"
+					name = "my_config"
+					spec = "{}"
+					profile = [{
+						name = "my-profile"
+						data = "[main]"
+					}]
+					recommend = [{
+						priority = 20
+						profile = "my-profile"
+					}]
+				}
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("Invalid Attribute Combination")
+		})
+		It("fails if profile is supplied without recommend", func() {
+			Terraform.Source(`
+			resource "rhcs_tuning_config" "tuning_config" {
+					cluster = "123"
+					name = "my_config"
+					profile = [{
+						name = "my-profile"
+						data = "[main]"
+					}]
+				}
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("Invalid Attribute Combination")
+		})
+		It("fails if a recommend rule selects an unknown profile", func() {
+			Terraform.Source(`
+			resource "rhcs_tuning_config" "tuning_config" {
+					cluster = "123"
+					name = "my_config"
+					profile = [{
+						name = "my-profile"
+						data = "[main]"
+					}]
+					recommend = [{
+						priority = 20
+						profile = "other-profile"
+					}]
+				}
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("Profile 'other-profile' isn't defined in 'profile'")
+		})
+		It("fails if a recommend rule has a negative priority", func() {
+			Terraform.Source(`
+			resource "rhcs_tuning_config" "tuning_config" {
+					cluster = "123"
+					name = "my_config"
+					profile = [{
+						name = "my-profile"
+						data = "[main]"
+					}]
+					recommend = [{
+						priority = -1
+						profile = "my-profile"
+					}]
+				}
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("value must be at least 0")
 		})
 		It("fails if name is not supplied", func() {
 			Terraform.Source(`
@@ -192,6 +291,59 @@ var _ = Describe("Tuning Configs", func() {
 			runOutput := Terraform.Apply()
 			Expect(runOutput.ExitCode).To(BeZero())
 		})
+
+		It("successfully creates a tuning_config object with profile and recommend", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithJSON(http.StatusOK, clusterTemplate),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithJSON(http.StatusOK, clusterReadyTemplate),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters/123/tuning_configs"),
+					VerifyJQ(".name", "my_config"),
+					VerifyJQ(".spec", tunedSpec),
+					RespondWithJSON(http.StatusCreated, tuningConfigTunedTemplate),
+				),
+			)
+
+			Terraform.Source(`
+				resource "rhcs_tuning_config" "tuning_config" {
+					cluster = "123"
+					name = "my_config"
+					profile = [{
+						name = "my-profile"
+						data = "[main]\nsummary=Custom profile\n"
+					}]
+					recommend = [{
+						priority = 20
+						profile = "my-profile"
+					}]
+				}
+	    	`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			actualResource, ok := Terraform.Resource("rhcs_tuning_config", "tuning_config").(map[string]any)
+			Expect(ok).To(BeTrue(), "Type conversion failed for the received resource state")
+			attributes := actualResource["attributes"].(map[string]any)
+			Expect(attributes["spec"]).To(BeNil())
+			Expect(attributes["profile"]).To(Equal([]any{
+				map[string]any{
+					"name": "my-profile",
+					"data": "[main]\nsummary=Custom profile\n",
+				},
+			}))
+			Expect(attributes["recommend"]).To(Equal([]any{
+				map[string]any{
+					"priority": float64(20),
+					"profile":  "my-profile",
+				},
+			}))
+		})
 	})
 
 	Context("tuning configs importing", func() {
@@ -241,10 +393,13 @@ var _ = Describe("Tuning Configs", func() {
 
 			Expect(actualResource["attributes"]).To(Equal(
 				map[string]any{
-					"cluster": "123",
-					"id":      "456",
-					"name":    "my_config",
-					"spec":    `{}`,
+					"cluster":   "123",
+					"id":        "456",
+					"name":      "my_config",
+					"spec":      `{}`,
+					"profile":   nil,
+					"recommend": nil,
+					"timeouts":  nil,
 				},
 			))
 		})
@@ -308,13 +463,39 @@ var _ = Describe("Tuning Configs", func() {
 
 			Expect(actualResource["attributes"]).To(Equal(
 				map[string]any{
-					"cluster": "123",
-					"id":      "456",
-					"name":    "my_config",
-					"spec":    `{"key":"value"}`,
+					"cluster":   "123",
+					"id":        "456",
+					"name":      "my_config",
+					"spec":      `{"key":"value"}`,
+					"profile":   nil,
+					"recommend": nil,
+					"timeouts":  nil,
 				},
 			))
 		})
+
+		It("ignores changes that don't change the content of the spec", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/tuning_configs/456"),
+					RespondWithJSON(http.StatusOK, tuningConfigTemplate),
+				),
+			)
+
+			Terraform.Source(`
+				resource "rhcs_tuning_config" "tuning_config" {
+					cluster = "123"
+					name = "my_config"
+					spec = "{ }"
+				}
+	    	`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			actualResource, ok := Terraform.Resource("rhcs_tuning_config", "tuning_config").(map[string]any)
+			Expect(ok).To(BeTrue(), "Type conversion failed for the received resource state")
+			Expect(actualResource["attributes"].(map[string]any)["spec"]).To(Equal(`{}`))
+		})
 	})
 
 	Context("tuning configs deletion", func() {